@@ -26,6 +26,11 @@ import (
 	"fmt"
 	"math"
 	"time"
+
+	"github.com/justenwalker/got/clock"
+	"github.com/justenwalker/got/fault"
+	"github.com/justenwalker/got/future"
+	"github.com/justenwalker/got/semaphore"
 )
 
 // RetryExhaustedError is an error that is returned by WithRetry when the maximum attempts have been exhausted.
@@ -44,11 +49,51 @@ func (e *RetryExhaustedError) Unwrap() error {
 	return e.Err
 }
 
-// WithRetry retries the Call using the RetryStrategy provided
+// WithRetry retries the Call using the RetryStrategy provided.
+// Delays between attempts are measured by the clock carried by ctx; see clock.NewContext.
 func WithRetry[T any](ctx context.Context, rs RetryStrategy, fn func(ctx context.Context) (T, error)) (T, error) {
+	return withRetry(ctx, rs, fn, nil, nil)
+}
+
+// WithRetryReport is like WithRetry, but also returns a Report describing each attempt that was made.
+func WithRetryReport[T any](ctx context.Context, rs RetryStrategy, fn func(ctx context.Context) (T, error)) (T, Report, error) {
+	var report Report
+	t, err := withRetry(ctx, rs, fn, &report, nil)
+	return t, report, err
+}
+
+// WithRetryState is like WithRetry, but resumes retrying from the given state, and updates it after each attempt.
+// The state can be saved with RetryState.Marshal, so that retries can be resumed after a restart.
+//
+// Attempts are numbered from state.Attempt, so that Delayer continues its schedule and MaximumAttempts
+// includes the previous attempts. If state.NextAttempt is in the future, WithRetryState waits until then
+// before making the first attempt. To resume jitter where it left off, use state.Rand as the source of randomness.
+// The attempts counted for each Policy of a Router are not saved.
+func WithRetryState[T any](ctx context.Context, rs RetryStrategy, state *RetryState, fn func(ctx context.Context) (T, error)) (T, error) {
+	return withRetry(ctx, rs, fn, nil, state)
+}
+
+func withRetry[T any](ctx context.Context, rs RetryStrategy, fn func(ctx context.Context) (T, error), report *Report, state *RetryState) (T, error) {
 	var zero T
-	if rs.ShouldRetry == nil {
-		return fn(ctx)
+	clk := clock.FromContext(ctx)
+	if state != nil {
+		fn = trackState(clk, state, fn)
+	}
+	if report != nil {
+		fn = recordAttempts(clk, report, fn)
+	}
+	if rs.Bulkhead != nil {
+		fn = bulkhead(rs.Bulkhead, fn)
+	}
+	tracer := rs.Tracer
+	if tracer == nil {
+		tracer = NopTracer{}
+	}
+	if rs.ShouldRetry == nil && rs.Router == nil {
+		actx := tracer.StartAttempt(ctx, Attribute{Key: AttrAttempt, Value: 1})
+		t, err := fn(actx)
+		tracer.EndAttempt(actx, err)
+		return t, err
 	}
 	// don't run if context is already finished
 	select {
@@ -57,24 +102,46 @@ func WithRetry[T any](ctx context.Context, rs RetryStrategy, fn func(ctx context
 	default:
 	}
 	var attempt int
+	if state != nil {
+		attempt = state.Attempt
+		if err := clock.SleepContext(ctx, state.NextAttempt.Sub(clk.Now())); err != nil {
+			return zero, err
+		}
+	}
+	var classAttempts []int
+	if rs.Router != nil {
+		classAttempts = make([]int, len(rs.Router.Policies)+1)
+	}
 	for {
 		attempt++
-		t, err := fn(ctx)
+		actx := tracer.StartAttempt(ctx, Attribute{Key: AttrAttempt, Value: attempt})
+		t, err := fn(actx)
 		if err == nil {
+			tracer.EndAttempt(actx, nil)
 			return t, nil
 		}
-		if !rs.ShouldRetry(err) {
+		p, ok := rs.policy(err, attempt, classAttempts)
+		if !ok {
+			tracer.EndAttempt(actx, err)
 			return zero, err
 		}
-		if rs.MaximumAttempts != 0 && attempt >= rs.MaximumAttempts {
+		if (rs.MaximumAttempts != 0 && attempt >= rs.MaximumAttempts) || (p.maxAttempts != 0 && p.attempt >= p.maxAttempts) {
+			tracer.EndAttempt(actx, err, Attribute{Key: AttrExhausted, Value: true})
 			return zero, &RetryExhaustedError{
 				Attempt: attempt,
 				Err:     err,
 			}
 		}
 		var delay time.Duration
-		if rs.Delayer != nil {
-			delay = rs.Delayer(attempt)
+		if p.delayer != nil {
+			delay = p.delayer(p.attempt)
+		}
+		if after, ok := fault.RetryAfter(err); ok && after > delay {
+			delay = after
+		}
+		tracer.EndAttempt(actx, err, Attribute{Key: AttrDelay, Value: delay})
+		if state != nil {
+			state.NextAttempt = clk.Now().Add(delay)
 		}
 		if delay == 0 {
 			select {
@@ -84,44 +151,37 @@ func WithRetry[T any](ctx context.Context, rs RetryStrategy, fn func(ctx context
 			}
 			continue
 		}
-		ticker := time.NewTicker(delay)
-		select {
-		case <-ctx.Done():
-			ticker.Stop()
-			return zero, ctx.Err()
-		case <-ticker.C:
-
+		start := clk.Now()
+		err = clock.SleepContext(ctx, delay)
+		if report != nil {
+			report.delayed(clk.Since(start))
+		}
+		if err != nil {
+			return zero, err
 		}
 	}
 }
 
-type result[T any] struct {
-	value T
-	err   error
-}
-
 // WithTimeout calls the given function and returns early if the function takes longer than the timeout provided.
 //
 // Note: The function is called with a context that is cancelled after the timeout duration.
 // The function provided should therefore support cancellation via context, otherwise this may leak resources.
+// The timeout is measured by the clock carried by ctx; see clock.NewContext.
+//
+// If the function panics, the panic is recovered and returned as a *fault.PanicError,
+// rather than crashing the program from the goroutine running the function.
 func WithTimeout[T any](ctx context.Context, timeout time.Duration, fn func(ctx context.Context) (T, error)) (T, error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
-	resultCh := make(chan result[T], 1)
+	ctx, cancel := clock.WithTimeout(ctx, timeout)
 	defer cancel()
-	go func() {
-		t, err := fn(ctx)
-		resultCh <- result[T]{value: t, err: err}
-	}()
-	var zero T
-	select {
-	case <-ctx.Done():
-		return zero, ctx.Err()
-	case r := <-resultCh:
-		if r.err != nil {
-			return zero, r.err
-		}
-		return r.value, nil
+	t, err := future.Go(ctx, func(ctx context.Context) (t T, err error) {
+		defer fault.Recover(&err)
+		return fn(ctx)
+	}).Get(ctx)
+	if err != nil {
+		var zero T
+		return zero, err
 	}
+	return t, nil
 }
 
 // RetryStrategy represents a strategy for retrying a specific operation in WithRetry.
@@ -134,7 +194,31 @@ type RetryStrategy struct {
 	ShouldRetry func(err error) bool
 	// Delayer is responsible for determining the delay duration before the next retry attempt.
 	// If it is not set, there will be no delays between retries.
+	// If the error suggests a longer delay using fault.RetryableAfter, the suggested delay is used instead.
 	Delayer func(attempt int) time.Duration
+	// Bulkhead limits how many attempts run at once, across all the calls to WithRetry sharing it.
+	// Each attempt acquires the semaphore before running, and releases it when it returns, so that
+	// a slot is not held while waiting to retry. If the context is done while waiting for a slot,
+	// WithRetry returns the context error.
+	// If it is not set, attempts are not limited.
+	Bulkhead semaphore.Semaphore
+	// Router, if set, chooses how to retry each error from a list of policies, replacing ShouldRetry and Delayer.
+	// MaximumAttempts still limits the total number of attempts.
+	Router *PolicyRouter
+	// Tracer is notified when each attempt starts and ends, so that it can be traced.
+	// If it is not set, attempts are not traced.
+	Tracer Tracer
+}
+
+func bulkhead[T any](sem semaphore.Semaphore, fn func(ctx context.Context) (T, error)) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		if err := sem.Acquire(ctx); err != nil {
+			var zero T
+			return zero, err
+		}
+		defer sem.Release()
+		return fn(ctx)
+	}
 }
 
 // RetryAlways always returns true, allowing a retry for any error.
@@ -216,6 +300,24 @@ func EqualJitter(rnd Rand, delayer Delayer) Delayer {
 	}
 }
 
+// ProportionalJitter augments the given Delayer by perturbing the delay by at most ±fraction of it.
+// For example, a fraction of 0.2 gives a delay within ±20% of the original.
+// ProportionalJitter is defined as:
+//
+//	delay + random_between( -delay*fraction , delay*fraction )
+//
+// ## Preconditions
+// 1. 0 <= fraction <= 1
+//
+// If the preconditions are not met, behavior is undefined.
+func ProportionalJitter(rnd Rand, delayer Delayer, fraction float64) Delayer {
+	return func(attempt int) time.Duration {
+		d := delayer(attempt)
+		spread := time.Duration(float64(d) * fraction)
+		return randomDurationBetween(rnd, d-spread, d+spread)
+	}
+}
+
 // DefaultDecorrelatedScale is the default scale factor for DecorrelatedJitter
 const DefaultDecorrelatedScale = 3.0
 
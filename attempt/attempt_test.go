@@ -26,9 +26,16 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"testing/quick"
 	"time"
+
+	"github.com/justenwalker/got/clock"
+	"github.com/justenwalker/got/fault"
+	"github.com/justenwalker/got/semaphore"
 )
 
 func ExampleWithRetry_decorrelated_jitter() {
@@ -297,6 +304,16 @@ func TestWithTimeout(t *testing.T) {
 			expectedValue: 0,
 			expectedErr:   mockErr,
 		},
+		{
+			name:    "panics",
+			ctx:     context.Background(),
+			timeout: 1 * time.Second,
+			fn: func(ctx context.Context) (int, error) {
+				panic(mockErr)
+			},
+			expectedValue: 0,
+			expectedErr:   mockErr,
+		},
 	}
 
 	for _, test := range tests {
@@ -400,6 +417,71 @@ func Test_EqualJitter(t *testing.T) {
 	}
 }
 
+func Test_ProportionalJitter(t *testing.T) {
+	type args struct {
+		rnd      Rand
+		delayer  Delayer
+		fraction float64
+	}
+	tests := []struct {
+		name string
+		args args
+		want time.Duration
+	}{
+		{
+			name: "lowest",
+			args: args{
+				rnd: func() float64 {
+					return 0
+				},
+				delayer:  Duration(time.Second),
+				fraction: 0.2,
+			},
+			want: 800 * time.Millisecond,
+		},
+		{
+			name: "middle",
+			args: args{
+				rnd: func() float64 {
+					return 0.5
+				},
+				delayer:  Duration(time.Second),
+				fraction: 0.2,
+			},
+			want: time.Second,
+		},
+		{
+			name: "high",
+			args: args{
+				rnd: func() float64 {
+					return 0.75
+				},
+				delayer:  Duration(time.Minute),
+				fraction: 0.5,
+			},
+			want: 75 * time.Second,
+		},
+		{
+			name: "no jitter",
+			args: args{
+				rnd: func() float64 {
+					return 0.9
+				},
+				delayer:  Duration(time.Minute),
+				fraction: 0,
+			},
+			want: time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProportionalJitter(tt.args.rnd, tt.args.delayer, tt.args.fraction)(1); got != tt.want {
+				t.Errorf("ProportionalJitter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestDecorrelatedJitter(t *testing.T) {
 	tests := []struct {
 		name  string
@@ -521,3 +603,544 @@ func TestDuration_Delay(t *testing.T) {
 		t.Fatal("ERROR", err)
 	}
 }
+
+func TestWithRetry_retryAfter(t *testing.T) {
+	var calls int
+	start := time.Now()
+	_, err := WithRetry(context.Background(), RetryStrategy{
+		MaximumAttempts: 2,
+		ShouldRetry:     fault.IsRetryable,
+		Delayer:         Duration(0),
+	}, func(ctx context.Context) (int, error) {
+		calls++
+		if calls == 1 {
+			return 0, fault.RetryableAfter(errors.New("rate limited"), 50*time.Millisecond)
+		}
+		return 1, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected retry to wait at least 50ms, waited %v", elapsed)
+	}
+}
+
+func TestWithRetry_fakeClock(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	ctx := clock.NewContext(context.Background(), clk)
+	var calls []time.Duration
+	done := make(chan error, 1)
+	go func() {
+		_, err := WithRetry(ctx, RetryStrategy{
+			MaximumAttempts: 4,
+			ShouldRetry:     RetryAlways,
+			Delayer:         ExponentialBackoff{InitialDelay: time.Second, MaxDelay: time.Minute}.Delay,
+		}, func(context.Context) (int, error) {
+			calls = append(calls, clk.Since(time.Unix(0, 0)))
+			return 0, errors.New("fail")
+		})
+		done <- err
+	}()
+	for _, d := range []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second} {
+		clk.BlockUntil(1)
+		clk.Advance(d)
+	}
+	var exhausted *RetryExhaustedError
+	if err := <-done; !errors.As(err, &exhausted) || exhausted.Attempt != 4 {
+		t.Fatalf("expected retries to be exhausted after 4 attempts, got=%v", err)
+	}
+	expect := []time.Duration{0, 2 * time.Second, 6 * time.Second, 14 * time.Second}
+	if fmt.Sprint(calls) != fmt.Sprint(expect) {
+		t.Errorf("expected=%v, got=%v", expect, calls)
+	}
+}
+
+func TestWithRetry_bulkhead(t *testing.T) {
+	rs := RetryStrategy{
+		MaximumAttempts: 3,
+		ShouldRetry:     RetryAlways,
+		Bulkhead:        semaphore.New(2),
+	}
+	var running, peak, calls atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = WithRetry(context.Background(), rs, func(context.Context) (int, error) {
+				cur := running.Add(1)
+				defer running.Add(-1)
+				for {
+					p := peak.Load()
+					if cur <= p || peak.CompareAndSwap(p, cur) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				if calls.Add(1)%2 == 0 {
+					return 0, errors.New("fail")
+				}
+				return 1, nil
+			})
+		}()
+	}
+	wg.Wait()
+	if p := peak.Load(); p > 2 {
+		t.Errorf("expected at most 2 concurrent attempts, got=%v", p)
+	}
+
+	rs.Bulkhead = semaphore.New(1)
+	rs.Bulkhead <- struct{}{} // the only slot is taken
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := WithRetry(ctx, rs, func(context.Context) (int, error) {
+		return 1, nil
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected=%v, got=%v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestWithRetry_router(t *testing.T) {
+	errRateLimited := errors.New("rate limited")
+	errNetwork := errors.New("network")
+	errInvalid := errors.New("invalid")
+	router := &PolicyRouter{
+		Policies: []Policy{
+			{Name: "rate-limit", Match: fault.IsOneOf(errRateLimited), MaximumAttempts: 2, Delayer: Duration(time.Minute)},
+			{Name: "network", Match: fault.IsOneOf(errNetwork), MaximumAttempts: 3, Delayer: func(attempt int) time.Duration {
+				return time.Duration(attempt) * time.Second
+			}},
+			{Name: "invalid", Match: fault.IsOneOf(errInvalid), MaximumAttempts: 1},
+		},
+	}
+	tests := []struct {
+		name      string
+		router    *PolicyRouter
+		errs      []error
+		calls     int
+		delays    []time.Duration
+		exhausted bool
+	}{
+		{
+			name:   "delays per class",
+			router: router,
+			errs:   []error{errNetwork, errRateLimited, errNetwork, nil},
+			calls:  4,
+			delays: []time.Duration{time.Second, time.Minute, 2 * time.Second},
+		},
+		{
+			name:      "class exhausted",
+			router:    router,
+			errs:      []error{errNetwork, errNetwork, errNetwork, nil},
+			calls:     3,
+			delays:    []time.Duration{time.Second, 2 * time.Second},
+			exhausted: true,
+		},
+		{
+			name:      "never retried",
+			router:    router,
+			errs:      []error{errInvalid, nil},
+			calls:     1,
+			exhausted: true,
+		},
+		{
+			name:   "no match",
+			router: router,
+			errs:   []error{errors.New("other"), nil},
+			calls:  1,
+		},
+		{
+			name:   "default",
+			router: &PolicyRouter{Policies: router.Policies, Default: &Policy{Delayer: Duration(time.Hour)}},
+			errs:   []error{errors.New("other"), nil},
+			calls:  2,
+			delays: []time.Duration{time.Hour},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := clock.NewFake(time.Unix(0, 0))
+			ctx := clock.NewContext(context.Background(), clk)
+			var calls int
+			done := make(chan error, 1)
+			go func() {
+				_, err := WithRetry(ctx, tt.router.Strategy(), func(context.Context) (int, error) {
+					err := tt.errs[calls]
+					calls++
+					return 0, err
+				})
+				done <- err
+			}()
+			for _, d := range tt.delays {
+				clk.BlockUntil(1)
+				clk.Advance(d)
+			}
+			err := <-done
+			if calls != tt.calls {
+				t.Errorf("expected calls=%v, got=%v", tt.calls, calls)
+			}
+			var exhausted *RetryExhaustedError
+			if got := errors.As(err, &exhausted); got != tt.exhausted {
+				t.Errorf("expected exhausted=%v, got=%v (%v)", tt.exhausted, got, err)
+			}
+		})
+	}
+}
+
+func TestWithRetryReport(t *testing.T) {
+	start := time.Unix(0, 0)
+	clk := clock.NewFake(start)
+	ctx := clock.NewContext(context.Background(), clk)
+	errFail := errors.New("fail")
+	type result struct {
+		v      int
+		report Report
+		err    error
+	}
+	done := make(chan result, 1)
+	go func() {
+		var calls int
+		v, report, err := WithRetryReport(ctx, RetryStrategy{
+			ShouldRetry: RetryAlways,
+			Delayer:     ExponentialBackoff{InitialDelay: time.Second, MaxDelay: time.Minute}.Delay,
+		}, func(context.Context) (int, error) {
+			calls++
+			clk.Advance(time.Duration(calls) * 100 * time.Millisecond)
+			if calls < 3 {
+				return 0, errFail
+			}
+			return calls, nil
+		})
+		done <- result{v, report, err}
+	}()
+	for _, d := range []time.Duration{2 * time.Second, 4 * time.Second} {
+		clk.BlockUntil(1)
+		clk.Advance(d)
+	}
+	res := <-done
+	if res.err != nil || res.v != 3 {
+		t.Fatalf("expected=3, got=%v, %v", res.v, res.err)
+	}
+	expect := []AttemptReport{
+		{Attempt: 1, Start: start, Duration: 100 * time.Millisecond, Err: errFail, Delay: 2 * time.Second},
+		{Attempt: 2, Start: start.Add(2100 * time.Millisecond), Duration: 200 * time.Millisecond, Err: errFail, Delay: 4 * time.Second},
+		{Attempt: 3, Start: start.Add(6300 * time.Millisecond), Duration: 300 * time.Millisecond},
+	}
+	if fmt.Sprint(res.report.Attempts) != fmt.Sprint(expect) {
+		t.Errorf("expected=%v, got=%v", expect, res.report.Attempts)
+	}
+	if got := res.report.Elapsed(); got != 6600*time.Millisecond {
+		t.Errorf("expected elapsed=%v, got=%v", 6600*time.Millisecond, got)
+	}
+	if got := res.report.Delayed(); got != 6*time.Second {
+		t.Errorf("expected delayed=%v, got=%v", 6*time.Second, got)
+	}
+	if err := res.report.Err(); err != nil {
+		t.Errorf("expected no error, got=%v", err)
+	}
+}
+
+type traceKey struct{}
+
+type testTracer struct {
+	events []string
+}
+
+func (tr *testTracer) StartAttempt(ctx context.Context, attrs ...Attribute) context.Context {
+	tr.events = append(tr.events, fmt.Sprintf("start %v", attrs))
+	return context.WithValue(ctx, traceKey{}, attrs[0].Value)
+}
+
+func (tr *testTracer) EndAttempt(ctx context.Context, err error, attrs ...Attribute) {
+	tr.events = append(tr.events, fmt.Sprintf("end %v %v %v", ctx.Value(traceKey{}), err, attrs))
+}
+
+func TestWithRetry_tracer(t *testing.T) {
+	errFail := errors.New("fail")
+	tests := []struct {
+		name   string
+		rs     RetryStrategy
+		errs   []error
+		expect []string
+	}{
+		{
+			name: "no retry",
+			errs: []error{errFail},
+			expect: []string{
+				"start [{attempt.number 1}]",
+				"end 1 fail []",
+			},
+		},
+		{
+			name: "retried",
+			rs:   RetryStrategy{ShouldRetry: RetryAlways, Delayer: Duration(time.Millisecond)},
+			errs: []error{errFail, nil},
+			expect: []string{
+				"start [{attempt.number 1}]",
+				"end 1 fail [{attempt.delay 1ms}]",
+				"start [{attempt.number 2}]",
+				"end 2 <nil> []",
+			},
+		},
+		{
+			name: "exhausted",
+			rs:   RetryStrategy{MaximumAttempts: 2, ShouldRetry: RetryAlways},
+			errs: []error{errFail, errFail},
+			expect: []string{
+				"start [{attempt.number 1}]",
+				"end 1 fail [{attempt.delay 0s}]",
+				"start [{attempt.number 2}]",
+				"end 2 fail [{attempt.exhausted true}]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &testTracer{}
+			tt.rs.Tracer = tr
+			var calls int
+			_, _ = WithRetry(context.Background(), tt.rs, func(ctx context.Context) (int, error) {
+				if ctx.Value(traceKey{}) != calls+1 {
+					t.Errorf("expected attempt context, got=%v", ctx.Value(traceKey{}))
+				}
+				err := tt.errs[calls]
+				calls++
+				return 0, err
+			})
+			if fmt.Sprint(tr.events) != fmt.Sprint(tt.expect) {
+				t.Errorf("expected=%q, got=%q", tt.expect, tr.events)
+			}
+		})
+	}
+}
+
+func TestWithTimeout_panic(t *testing.T) {
+	_, err := WithTimeout(context.Background(), time.Second, func(ctx context.Context) (int, error) {
+		panic("boom")
+	})
+	var perr *fault.PanicError
+	if !errors.As(err, &perr) || perr.Value != "boom" {
+		t.Fatalf("expected panic error, got=%v", err)
+	}
+}
+
+func TestRetryBatch(t *testing.T) {
+	errTemp := fault.MarkTemporary(errors.New("temporary"))
+	errPerm := errors.New("permanent")
+	tests := []struct {
+		name      string
+		rs        RetryStrategy
+		fails     map[int][]error // item -> error for each attempt it is submitted
+		expect    []int
+		batches   [][]int
+		failed    []int
+		exhausted []int
+	}{
+		{
+			name:    "all succeed",
+			rs:      RetryStrategy{ShouldRetry: fault.IsTemporary},
+			expect:  []int{10, 20, 30},
+			batches: [][]int{{1, 2, 3}},
+		},
+		{
+			name: "retries failed items",
+			rs:   RetryStrategy{ShouldRetry: fault.IsTemporary},
+			fails: map[int][]error{
+				1: {errTemp, errTemp},
+				3: {errTemp},
+			},
+			expect:  []int{10, 20, 30},
+			batches: [][]int{{1, 2, 3}, {1, 3}, {1}},
+		},
+		{
+			name: "permanent failure",
+			rs:   RetryStrategy{ShouldRetry: fault.IsTemporary},
+			fails: map[int][]error{
+				1: {errPerm},
+				3: {errTemp},
+			},
+			expect:  []int{0, 20, 30},
+			batches: [][]int{{1, 2, 3}, {3}},
+			failed:  []int{0},
+		},
+		{
+			name: "exhausted",
+			rs:   RetryStrategy{MaximumAttempts: 2, ShouldRetry: fault.IsTemporary},
+			fails: map[int][]error{
+				2: {errTemp, errTemp},
+			},
+			expect:    []int{10, 0, 30},
+			batches:   [][]int{{1, 2, 3}, {2}},
+			failed:    []int{1},
+			exhausted: []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var batches [][]int
+			attempts := make(map[int]int)
+			got, err := RetryBatch(context.Background(), tt.rs, []int{1, 2, 3}, func(ctx context.Context, items []int) (map[int]int, map[int]error) {
+				batches = append(batches, slices.Clone(items))
+				results := make(map[int]int)
+				errs := make(map[int]error)
+				for i, item := range items {
+					n := attempts[item]
+					attempts[item]++
+					if n < len(tt.fails[item]) {
+						errs[i] = tt.fails[item][n]
+						continue
+					}
+					results[i] = item * 10
+				}
+				return results, errs
+			})
+			if fmt.Sprint(got) != fmt.Sprint(tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+			if fmt.Sprint(batches) != fmt.Sprint(tt.batches) {
+				t.Errorf("expected batches=%v, got=%v", tt.batches, batches)
+			}
+			var failed, exhausted []int
+			var berr *BatchError
+			if errors.As(err, &berr) {
+				for _, i := range berr.indexes() {
+					failed = append(failed, i)
+					var rerr *RetryExhaustedError
+					if errors.As(berr.Errs[i], &rerr) {
+						exhausted = append(exhausted, i)
+					}
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if fmt.Sprint(failed) != fmt.Sprint(tt.failed) {
+				t.Errorf("expected failed=%v, got=%v", tt.failed, failed)
+			}
+			if fmt.Sprint(exhausted) != fmt.Sprint(tt.exhausted) {
+				t.Errorf("expected exhausted=%v, got=%v", tt.exhausted, exhausted)
+			}
+		})
+	}
+}
+
+func TestBatchError_Error(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    *BatchError
+		expect string
+	}{
+		{name: "empty", err: &BatchError{Total: 3}, expect: "attempt: 0 of 3 batch items failed"},
+		{
+			name:   "failed",
+			err:    &BatchError{Errs: map[int]error{2: errors.New("b"), 1: errors.New("a")}, Total: 3},
+			expect: "attempt: 2 of 3 batch items failed. first error: a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.err.Error(); got != tt.expect {
+				t.Errorf("expected=%q, got=%q", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestWithRetryState(t *testing.T) {
+	start := time.Unix(0, 0)
+	clk := clock.NewFake(start)
+	rs := RetryStrategy{
+		MaximumAttempts: 5,
+		ShouldRetry:     RetryAlways,
+		Delayer:         ExponentialBackoff{InitialDelay: time.Second, MaxDelay: time.Minute}.Delay,
+	}
+	var calls []time.Duration
+	fail := func(context.Context) (int, error) {
+		calls = append(calls, clk.Since(start))
+		return 0, fault.InvalidInputf("fail %d", len(calls))
+	}
+
+	// the first run is interrupted after 2 attempts.
+	ctx, cancel := context.WithCancel(clock.NewContext(context.Background(), clk))
+	var state RetryState
+	done := make(chan error, 1)
+	go func() {
+		_, err := WithRetryState(ctx, rs, &state, func(ctx context.Context) (int, error) {
+			if len(calls) == 1 {
+				cancel()
+			}
+			return fail(ctx)
+		})
+		done <- err
+	}()
+	clk.BlockUntil(1)
+	clk.Advance(2 * time.Second)
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected=%v, got=%v", context.Canceled, err)
+	}
+	data, err := state.Marshal()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// the second run resumes from the saved state.
+	var resumed RetryState
+	if err = resumed.Unmarshal(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resumed.Attempt != 2 || resumed.Err().Error() != "fail 2" || !fault.IsInvalidInput(resumed.Err()) {
+		t.Fatalf("unexpected state: %+v", resumed)
+	}
+	if expect := start.Add(6 * time.Second); !resumed.NextAttempt.Equal(expect) {
+		t.Errorf("expected=%v, got=%v", expect, resumed.NextAttempt)
+	}
+	ctx = clock.NewContext(context.Background(), clk)
+	go func() {
+		_, err := WithRetryState(ctx, rs, &resumed, fail)
+		done <- err
+	}()
+	for _, d := range []time.Duration{4 * time.Second, 8 * time.Second, 16 * time.Second} {
+		clk.BlockUntil(1)
+		clk.Advance(d)
+	}
+	var exhausted *RetryExhaustedError
+	if err = <-done; !errors.As(err, &exhausted) || exhausted.Attempt != 5 {
+		t.Fatalf("expected retries to be exhausted after 5 attempts, got=%v", err)
+	}
+	expect := []time.Duration{0, 2 * time.Second, 6 * time.Second, 14 * time.Second, 30 * time.Second}
+	if fmt.Sprint(calls) != fmt.Sprint(expect) {
+		t.Errorf("expected=%v, got=%v", expect, calls)
+	}
+	if resumed.Attempt != 5 {
+		t.Errorf("expected=5, got=%v", resumed.Attempt)
+	}
+}
+
+func TestRetryState_Rand(t *testing.T) {
+	state := RetryState{Seed: 42}
+	rnd := state.Rand()
+	first := []float64{rnd(), rnd()}
+	data, err := state.Marshal()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	next := []float64{rnd(), rnd()}
+
+	var resumed RetryState
+	if err = resumed.Unmarshal(data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	rnd = resumed.Rand()
+	if got := []float64{rnd(), rnd()}; fmt.Sprint(got) != fmt.Sprint(next) {
+		t.Errorf("expected=%v, got=%v", next, got)
+	}
+	if fmt.Sprint(first) == fmt.Sprint(next) {
+		t.Errorf("expected the sequence to advance, got=%v then %v", first, next)
+	}
+	for _, v := range append(first, next...) {
+		if v < 0 || v >= 1 {
+			t.Errorf("expected value in [0,1), got=%v", v)
+		}
+	}
+}
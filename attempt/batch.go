@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package attempt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/justenwalker/got/fault"
+)
+
+// BatchError is returned by RetryBatch when some of the items could not be processed.
+type BatchError struct {
+	// Errs holds the final error for each failed item, keyed by the item's index.
+	// Items which were still being retried when the retries were exhausted have a *RetryExhaustedError.
+	Errs map[int]error
+	// Total is the number of items in the batch.
+	Total int
+}
+
+func (e *BatchError) Error() string {
+	if len(e.Errs) == 0 {
+		return fmt.Sprintf("attempt: 0 of %d batch items failed", e.Total)
+	}
+	return fmt.Sprintf("attempt: %d of %d batch items failed. first error: %v", len(e.Errs), e.Total, e.Errs[e.indexes()[0]])
+}
+
+// Unwrap returns the errors of the failed items, in the order of the items.
+func (e *BatchError) Unwrap() []error {
+	indexes := e.indexes()
+	errs := make([]error, len(indexes))
+	for i, idx := range indexes {
+		errs[i] = e.Errs[idx]
+	}
+	return errs
+}
+
+func (e *BatchError) indexes() []int {
+	indexes := make([]int, 0, len(e.Errs))
+	for i := range e.Errs {
+		indexes = append(indexes, i)
+	}
+	slices.Sort(indexes)
+	return indexes
+}
+
+// RetryBatch processes items with fn, retrying only the items which failed, using the RetryStrategy provided.
+// This suits bulk APIs which can partially fail.
+//
+// fn is called with the items still to be processed, and returns the results and errors for them,
+// keyed by their index in the slice it was given. Items without an error are successful.
+// Each failed item is retried if rs would retry its error; the delay before the next attempt is determined
+// by the error with the longest fault.RetryAfter hint, or by the first error.
+//
+// RetryBatch returns the results in the order of items. If any item failed, it also returns a *BatchError;
+// the results of the failed items are the zero value. If the context is done, the items which were never
+// processed fail with the context error, and the others keep their last error.
+func RetryBatch[T, R any](ctx context.Context, rs RetryStrategy, items []T, fn func(ctx context.Context, items []T) (map[int]R, map[int]error)) ([]R, error) {
+	results := make([]R, len(items))
+	errs := make(map[int]error)
+	pending := make([]int, len(items))
+	for i := range pending {
+		pending[i] = i
+	}
+	batch := make([]T, 0, len(items))
+	_, err := WithRetry(ctx, rs, func(ctx context.Context) (struct{}, error) {
+		batch = batch[:0]
+		for _, i := range pending {
+			batch = append(batch, items[i])
+		}
+		res, itemErrs := fn(ctx, batch)
+		var retry []int
+		var retryErr error
+		for j, i := range pending {
+			err := itemErrs[j]
+			if err == nil {
+				results[i] = res[j]
+				delete(errs, i)
+				continue
+			}
+			errs[i] = err
+			if !rs.retryable(err) {
+				continue
+			}
+			retry = append(retry, i)
+			if retryErr == nil {
+				retryErr = err
+			} else if after, ok := fault.RetryAfter(err); ok {
+				if prev, _ := fault.RetryAfter(retryErr); after > prev {
+					retryErr = err
+				}
+			}
+		}
+		pending = retry
+		return struct{}{}, retryErr
+	})
+	if err != nil {
+		var exhausted *RetryExhaustedError
+		isExhausted := errors.As(err, &exhausted)
+		for _, i := range pending {
+			switch {
+			case isExhausted:
+				errs[i] = &RetryExhaustedError{Attempt: exhausted.Attempt, Err: errs[i]}
+			case errs[i] == nil:
+				errs[i] = err
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return results, &BatchError{Errs: errs, Total: len(items)}
+	}
+	return results, nil
+}
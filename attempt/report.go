@@ -0,0 +1,102 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package attempt
+
+import (
+	"context"
+	"time"
+
+	"github.com/justenwalker/got/clock"
+)
+
+// Report describes the attempts made by WithRetryReport.
+type Report struct {
+	// Attempts describes each attempt, in the order they were made.
+	Attempts []AttemptReport
+}
+
+// AttemptReport describes a single attempt made by WithRetryReport.
+type AttemptReport struct {
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int
+	// Start is the time the attempt started.
+	Start time.Time
+	// Duration is how long the attempt took.
+	Duration time.Duration
+	// Err is the error returned by the attempt, or nil if it succeeded.
+	Err error
+	// Delay is how long WithRetryReport waited after the attempt, before the next attempt.
+	// It is 0 if there was no next attempt.
+	Delay time.Duration
+}
+
+// End returns the time the attempt finished.
+func (a AttemptReport) End() time.Time {
+	return a.Start.Add(a.Duration)
+}
+
+// Elapsed returns the total time taken by the attempts and the delays between them.
+func (r Report) Elapsed() time.Duration {
+	if len(r.Attempts) == 0 {
+		return 0
+	}
+	last := r.Attempts[len(r.Attempts)-1]
+	return last.End().Add(last.Delay).Sub(r.Attempts[0].Start)
+}
+
+// Delayed returns the total time spent waiting between attempts.
+func (r Report) Delayed() time.Duration {
+	var d time.Duration
+	for _, a := range r.Attempts {
+		d += a.Delay
+	}
+	return d
+}
+
+// Err returns the error of the last attempt, or nil if there were no attempts or the last attempt succeeded.
+func (r Report) Err() error {
+	if len(r.Attempts) == 0 {
+		return nil
+	}
+	return r.Attempts[len(r.Attempts)-1].Err
+}
+
+// delayed records the delay after the last attempt.
+func (r *Report) delayed(d time.Duration) {
+	if len(r.Attempts) > 0 {
+		r.Attempts[len(r.Attempts)-1].Delay = d
+	}
+}
+
+func recordAttempts[T any](clk clock.Clock, report *Report, fn func(ctx context.Context) (T, error)) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		start := clk.Now()
+		t, err := fn(ctx)
+		report.Attempts = append(report.Attempts, AttemptReport{
+			Attempt:  len(report.Attempts) + 1,
+			Start:    start,
+			Duration: clk.Since(start),
+			Err:      err,
+		})
+		return t, err
+	}
+}
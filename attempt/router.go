@@ -0,0 +1,109 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package attempt
+
+// Policy describes how to retry a class of errors, for a PolicyRouter.
+type Policy struct {
+	// Name describes the class of errors.
+	Name string
+	// Match reports whether an error belongs to the class.
+	// Functions such as fault.IsTemporary, fault.IsOneOf and fault.MatchCode can be used here.
+	Match func(err error) bool
+	// MaximumAttempts limits the number of attempts which fail with an error of this class.
+	// If MaximumAttempts is 0, errors of this class are retried indefinitely; if it is 1, they are never retried
+	// and WithRetry returns a RetryExhaustedError.
+	MaximumAttempts int
+	// Delayer determines the delay before retrying an error of this class. It is called with the number of attempts
+	// which failed with an error of this class, so that each class backs off independently.
+	// If it is not set, there is no delay.
+	Delayer Delayer
+}
+
+// PolicyRouter retries errors according to the first Policy which matches them, so that different kinds of errors
+// can be retried differently: for example, rate-limit errors with long waits, network errors with short jittered
+// delays, and invalid input never.
+//
+// Errors which match no policy are retried according to Default, or not retried if it is nil.
+// A PolicyRouter is used by setting it as the Router of a RetryStrategy; see Strategy.
+type PolicyRouter struct {
+	// Policies are tried in order, and the first one which matches an error is used.
+	Policies []Policy
+	// Default is the Policy for errors which match no other policy. Its Match function is ignored.
+	Default *Policy
+}
+
+// Strategy returns a RetryStrategy which retries errors according to the router.
+func (r *PolicyRouter) Strategy() RetryStrategy {
+	return RetryStrategy{Router: r}
+}
+
+// Match returns the policy for err, and false if err should not be retried.
+func (r *PolicyRouter) Match(err error) (Policy, bool) {
+	if _, p := r.match(err); p != nil {
+		return *p, true
+	}
+	return Policy{}, false
+}
+
+// match returns the index and policy for err, where the index of Default is len(r.Policies).
+func (r *PolicyRouter) match(err error) (int, *Policy) {
+	for i := range r.Policies {
+		if p := &r.Policies[i]; p.Match != nil && p.Match(err) {
+			return i, p
+		}
+	}
+	return len(r.Policies), r.Default
+}
+
+// retryPolicy is how WithRetry handles a failed attempt.
+type retryPolicy struct {
+	// attempt is the number of failed attempts counted against maxAttempts and passed to delayer.
+	attempt     int
+	maxAttempts int
+	delayer     Delayer
+}
+
+// policy returns how to handle err after the given attempt, and false if it should not be retried.
+// classAttempts counts the failed attempts for each policy of the Router.
+func (rs *RetryStrategy) policy(err error, attempt int, classAttempts []int) (retryPolicy, bool) {
+	if rs.Router == nil {
+		if !rs.ShouldRetry(err) {
+			return retryPolicy{}, false
+		}
+		return retryPolicy{attempt: attempt, delayer: rs.Delayer}, true
+	}
+	i, p := rs.Router.match(err)
+	if p == nil {
+		return retryPolicy{}, false
+	}
+	classAttempts[i]++
+	return retryPolicy{attempt: classAttempts[i], maxAttempts: p.MaximumAttempts, delayer: p.Delayer}, true
+}
+
+// retryable reports whether rs would retry err.
+func (rs *RetryStrategy) retryable(err error) bool {
+	if rs.Router != nil {
+		_, p := rs.Router.match(err)
+		return p != nil
+	}
+	return rs.ShouldRetry != nil && rs.ShouldRetry(err)
+}
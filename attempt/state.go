@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package attempt
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/justenwalker/got/clock"
+	"github.com/justenwalker/got/fault"
+)
+
+// RetryState records the progress of WithRetryState, so that it can be saved and resumed later,
+// for example by a queue consumer which is restarted while retrying a message.
+//
+// The zero value is the state before the first attempt.
+type RetryState struct {
+	// Attempt is the number of attempts made so far.
+	Attempt int `json:"attempt"`
+	// LastError describes the error returned by the last attempt, or is nil if it succeeded.
+	LastError *fault.Envelope `json:"lastError,omitempty"`
+	// NextAttempt is the earliest time for the next attempt, following the delay after the last attempt.
+	NextAttempt time.Time `json:"nextAttempt"`
+	// Seed is the state of the random numbers returned by Rand, so that jitter continues its sequence.
+	Seed uint64 `json:"seed"`
+}
+
+// Marshal encodes the state as JSON.
+func (s *RetryState) Marshal() ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// Unmarshal decodes a state encoded by Marshal.
+func (s *RetryState) Unmarshal(data []byte) error {
+	*s = RetryState{}
+	return json.Unmarshal(data, s)
+}
+
+// Err returns the error of the last attempt, reconstructed from LastError; see fault.Envelope.
+func (s *RetryState) Err() error {
+	if s.LastError == nil {
+		return nil
+	}
+	return s.LastError.Err()
+}
+
+// Rand returns a Rand which generates a deterministic sequence from Seed, advancing it with each value.
+// Using it for jitter allows a resumed WithRetryState to continue the sequence rather than repeat it.
+// Set Seed to a random value before the first attempt so that different states don't jitter identically.
+//
+// **NOTE**: The Rand modifies the state, and is therefore not safe to be called concurrently.
+func (s *RetryState) Rand() Rand {
+	return func() float64 {
+		// splitmix64
+		s.Seed += 0x9e3779b97f4a7c15
+		z := s.Seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		z ^= z >> 31
+		return float64(z>>11) / (1 << 53)
+	}
+}
+
+func trackState[T any](clk clock.Clock, state *RetryState, fn func(ctx context.Context) (T, error)) func(ctx context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		t, err := fn(ctx)
+		state.Attempt++
+		state.LastError = nil
+		if err != nil {
+			env := fault.ToEnvelope(err)
+			state.LastError = &env
+		}
+		state.NextAttempt = clk.Now()
+		return t, err
+	}
+}
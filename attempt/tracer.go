@@ -0,0 +1,82 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package attempt
+
+import "context"
+
+// Attribute keys describing an attempt to a Tracer.
+const (
+	// AttrAttempt is the number of the attempt, starting at 1, as an int.
+	AttrAttempt = "attempt.number"
+	// AttrDelay is the delay before the next attempt, as a time.Duration.
+	// It is only set when the attempt failed and will be retried.
+	AttrDelay = "attempt.delay"
+	// AttrExhausted is true when the attempt failed and the maximum attempts have been reached.
+	AttrExhausted = "attempt.exhausted"
+)
+
+// Attribute is a key-value pair describing an attempt to a Tracer.
+type Attribute struct {
+	Key   string
+	Value any
+}
+
+// Tracer traces the attempts made by WithRetry.
+//
+// It is designed so that an adapter can create a span for each attempt, using a tracing library such as
+// OpenTelemetry, without this package depending on it:
+//
+//	func (t otelTracer) StartAttempt(ctx context.Context, attrs ...attempt.Attribute) context.Context {
+//	    ctx, _ = t.tracer.Start(ctx, "attempt", trace.WithAttributes(convert(attrs)...))
+//	    return ctx
+//	}
+//
+//	func (t otelTracer) EndAttempt(ctx context.Context, err error, attrs ...attempt.Attribute) {
+//	    span := trace.SpanFromContext(ctx)
+//	    span.SetAttributes(convert(attrs)...)
+//	    if err != nil {
+//	        span.RecordError(err)
+//	        span.SetStatus(codes.Error, err.Error())
+//	    }
+//	    span.End()
+//	}
+type Tracer interface {
+	// StartAttempt is called before each attempt, and returns the context for the attempt.
+	// The attributes include AttrAttempt.
+	StartAttempt(ctx context.Context, attrs ...Attribute) context.Context
+	// EndAttempt is called after each attempt with the context returned by StartAttempt and the attempt's error,
+	// which is nil if the attempt succeeded.
+	// The attributes include AttrDelay if the attempt will be retried, or AttrExhausted if it won't be
+	// because the maximum attempts have been reached.
+	EndAttempt(ctx context.Context, err error, attrs ...Attribute)
+}
+
+// NopTracer is a Tracer which does nothing.
+type NopTracer struct{}
+
+// StartAttempt implements Tracer by returning ctx.
+func (NopTracer) StartAttempt(ctx context.Context, _ ...Attribute) context.Context {
+	return ctx
+}
+
+// EndAttempt implements Tracer by doing nothing.
+func (NopTracer) EndAttempt(context.Context, error, ...Attribute) {}
@@ -0,0 +1,106 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package bimap contains a bidirectional map, which can look up keys by value as well as values by key.
+package bimap
+
+import (
+	"iter"
+	"maps"
+)
+
+// BiMap is a one-to-one mapping between keys and values: each key has exactly one value, and each value exactly one key.
+// Putting a key or value that is already present replaces its previous pairing, so the two directions are always consistent.
+//
+// A BiMap must be created with New. It is not safe for concurrent use.
+type BiMap[K comparable, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+}
+
+// New creates an empty BiMap.
+func New[K comparable, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{
+		forward: make(map[K]V),
+		inverse: make(map[V]K),
+	}
+}
+
+// Put pairs key with value. Any existing pairing of key with another value,
+// or of value with another key, is removed first.
+func (m *BiMap[K, V]) Put(key K, value V) {
+	if old, ok := m.forward[key]; ok {
+		delete(m.inverse, old)
+	}
+	if old, ok := m.inverse[value]; ok {
+		delete(m.forward, old)
+	}
+	m.forward[key] = value
+	m.inverse[value] = key
+}
+
+// Get returns the value paired with key, and whether it is present.
+func (m *BiMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.forward[key]
+	return v, ok
+}
+
+// GetKey returns the key paired with value, and whether it is present.
+func (m *BiMap[K, V]) GetKey(value V) (K, bool) {
+	k, ok := m.inverse[value]
+	return k, ok
+}
+
+// Delete removes key and its value, and reports whether key was present.
+func (m *BiMap[K, V]) Delete(key K) bool {
+	v, ok := m.forward[key]
+	if ok {
+		delete(m.forward, key)
+		delete(m.inverse, v)
+	}
+	return ok
+}
+
+// DeleteValue removes value and its key, and reports whether value was present.
+func (m *BiMap[K, V]) DeleteValue(value V) bool {
+	k, ok := m.inverse[value]
+	if ok {
+		delete(m.inverse, value)
+		delete(m.forward, k)
+	}
+	return ok
+}
+
+// Len returns the number of pairs in the BiMap.
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// All returns a sequence of the key and value pairs, in no particular order.
+func (m *BiMap[K, V]) All() iter.Seq2[K, V] {
+	return maps.All(m.forward)
+}
+
+// Inverse returns a view of the BiMap with keys and values swapped.
+// The view shares its contents with m, so changes to either are visible in both.
+func (m *BiMap[K, V]) Inverse() *BiMap[V, K] {
+	return &BiMap[V, K]{forward: m.inverse, inverse: m.forward}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package bimap
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleBiMap() {
+	ids := New[int, string]()
+	ids.Put(1, "alice")
+	ids.Put(2, "bob")
+	name, _ := ids.Get(1)
+	id, _ := ids.GetKey("bob")
+	fmt.Println(name, id)
+	// Output:
+	// alice 2
+}
+
+func testExpectPair(t *testing.T, m *BiMap[int, string], k int, v string) {
+	t.Helper()
+	if actual, ok := m.Get(k); !ok || actual != v {
+		t.Errorf("Get(%v): expected=%v, got=%v", k, v, actual)
+	}
+	if actual, ok := m.GetKey(v); !ok || actual != k {
+		t.Errorf("GetKey(%v): expected=%v, got=%v", v, k, actual)
+	}
+}
+
+func TestBiMap_Put(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	testExpectPair(t, m, 1, "a")
+	testExpectPair(t, m, 2, "b")
+
+	// overwrite the value of an existing key
+	m.Put(1, "c")
+	testExpectPair(t, m, 1, "c")
+	if _, ok := m.GetKey("a"); ok {
+		t.Errorf("expected old value to be removed")
+	}
+
+	// move an existing value to a new key
+	m.Put(3, "b")
+	testExpectPair(t, m, 3, "b")
+	if _, ok := m.Get(2); ok {
+		t.Errorf("expected old key to be removed")
+	}
+	if m.Len() != 2 {
+		t.Errorf("expected=2, got=%v", m.Len())
+	}
+}
+
+func TestBiMap_Delete(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	m.Put(2, "b")
+	if !m.Delete(1) || m.Delete(1) {
+		t.Errorf("expected Delete to report presence")
+	}
+	if _, ok := m.GetKey("a"); ok {
+		t.Errorf("expected value to be removed")
+	}
+	if !m.DeleteValue("b") || m.DeleteValue("b") {
+		t.Errorf("expected DeleteValue to report presence")
+	}
+	if _, ok := m.Get(2); ok || m.Len() != 0 {
+		t.Errorf("expected key to be removed")
+	}
+}
+
+func TestBiMap_Inverse(t *testing.T) {
+	m := New[int, string]()
+	m.Put(1, "a")
+	inv := m.Inverse()
+	if k, ok := inv.Get("a"); !ok || k != 1 {
+		t.Errorf("expected=1, got=%v", k)
+	}
+	inv.Put("b", 2)
+	testExpectPair(t, m, 2, "b")
+	count := 0
+	for k, v := range m.All() {
+		testExpectPair(t, m, k, v)
+		count++
+	}
+	if count != 2 {
+		t.Errorf("expected=2, got=%v", count)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package cache contains the Cache interface shared by the cache implementations in its subpackages,
+// and helpers built on top of it.
+//
+// The lru subpackage contains a least-recently-used cache.
+// LoadingCache wraps a Cache to load missing values on demand.
+package cache
+
+// Cache is a key/value store with bounded size, which may evict entries to make room for new ones.
+type Cache[K comparable, V any] interface {
+	// Get returns the value for key, and whether it was found.
+	Get(key K) (V, bool)
+	// Put sets the value for key.
+	Put(key K, value V)
+	// Remove removes key from the cache, and reports whether it was present.
+	Remove(key K) bool
+	// Len returns the number of entries in the cache.
+	Len() int
+}
@@ -0,0 +1,204 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/justenwalker/got/attempt"
+	"github.com/justenwalker/got/clock"
+	"github.com/justenwalker/got/fault"
+)
+
+// Loader loads the value for a key which is not in a LoadingCache.
+type Loader[K comparable, V any] func(ctx context.Context, key K) (V, error)
+
+// LoadingOption configures a LoadingCache.
+type LoadingOption[K comparable, V any] func(*LoadingCache[K, V])
+
+// WithNegativeTTL makes a LoadingCache remember errors returned by the loader for ttl,
+// so that Get returns the same error without calling the loader again until it has passed.
+// Context errors are never remembered. By default, errors are not remembered.
+func WithNegativeTTL[K comparable, V any](ttl time.Duration) LoadingOption[K, V] {
+	return func(c *LoadingCache[K, V]) {
+		c.negativeTTL = ttl
+	}
+}
+
+// WithLoadRecorder makes a LoadingCache report lookups and loads to r, in addition to counting them for Stats.
+// Evictions are reported by the underlying Cache, not the LoadingCache.
+func WithLoadRecorder[K comparable, V any](r Recorder) LoadingOption[K, V] {
+	return func(c *LoadingCache[K, V]) {
+		c.stats.Next = r
+	}
+}
+
+// WithLoadClock sets the clock used to expire remembered errors and to measure load durations. By default, it is clock.Real.
+func WithLoadClock[K comparable, V any](clk clock.Clock) LoadingOption[K, V] {
+	return func(c *LoadingCache[K, V]) {
+		c.now = clk.Now
+	}
+}
+
+// WithLoadRetry makes a LoadingCache retry failed loads using attempt.WithRetry with the given strategy.
+func WithLoadRetry[K comparable, V any](rs attempt.RetryStrategy) LoadingOption[K, V] {
+	return func(c *LoadingCache[K, V]) {
+		c.retry = rs
+	}
+}
+
+// LoadingCache is a read-through cache: values missing from the underlying Cache are loaded
+// by calling a Loader, and stored in the Cache for later calls.
+//
+// Concurrent calls to Get for the same missing key share a single call to the loader.
+// A LoadingCache must be created with NewLoading, and is safe for concurrent use
+// if the underlying Cache is.
+type LoadingCache[K comparable, V any] struct {
+	cache       Cache[K, V]
+	load        Loader[K, V]
+	retry       attempt.RetryStrategy
+	negativeTTL time.Duration
+	now         func() time.Time
+	stats       Counters
+
+	mu       sync.Mutex
+	calls    map[K]*loadCall[V]
+	negative map[K]negativeEntry
+}
+
+type loadCall[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type negativeEntry struct {
+	err     error
+	expires time.Time
+}
+
+// NewLoading creates a LoadingCache which stores values in cache, and loads missing values with load.
+func NewLoading[K comparable, V any](cache Cache[K, V], load Loader[K, V], opts ...LoadingOption[K, V]) *LoadingCache[K, V] {
+	c := &LoadingCache[K, V]{
+		cache:    cache,
+		load:     load,
+		now:      time.Now,
+		calls:    make(map[K]*loadCall[V]),
+		negative: make(map[K]negativeEntry),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Get returns the value for key, loading it if it is not in the cache.
+// If the loader fails, the error is returned and nothing is stored in the cache.
+//
+// The loader is called with the context of the caller that started the load.
+// Other callers waiting for the same key return early with the context error if their own context is done.
+// If the load fails because the context of the caller that started it is done, the waiting callers load the key again.
+func (c *LoadingCache[K, V]) Get(ctx context.Context, key K) (V, error) {
+	if v, ok := c.cache.Get(key); ok {
+		c.stats.RecordHit()
+		return v, nil
+	}
+	c.stats.RecordMiss()
+	var zero V
+	for {
+		c.mu.Lock()
+		if neg, ok := c.negative[key]; ok {
+			if !c.now().After(neg.expires) {
+				c.mu.Unlock()
+				return zero, neg.err
+			}
+			delete(c.negative, key)
+		}
+		call, ok := c.calls[key]
+		if !ok {
+			// a load which completed after the lookup above has stored the value before removing its call.
+			if v, hit := c.cache.Get(key); hit {
+				c.mu.Unlock()
+				return v, nil
+			}
+			call = &loadCall[V]{done: make(chan struct{})}
+			c.calls[key] = call
+			c.mu.Unlock()
+			c.doLoad(ctx, key, call)
+			return call.value, call.err
+		}
+		c.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-call.done:
+		}
+		if !isContextErr(call.err) || ctx.Err() != nil {
+			return call.value, call.err
+		}
+	}
+}
+
+// doLoad loads the value for key into call, and stores it in the cache. It completes the call even if the loader panics,
+// so that callers waiting for the same key are not blocked; the panic is returned as a *fault.PanicError.
+func (c *LoadingCache[K, V]) doLoad(ctx context.Context, key K, call *loadCall[V]) {
+	start := c.now()
+	defer func() {
+		c.stats.RecordLoad(c.now().Sub(start), call.err)
+		c.mu.Lock()
+		delete(c.calls, key)
+		if call.err != nil && c.negativeTTL > 0 && !isContextErr(call.err) {
+			c.negative[key] = negativeEntry{err: call.err, expires: c.now().Add(c.negativeTTL)}
+		}
+		c.mu.Unlock()
+		close(call.done)
+	}()
+	defer fault.Recover(&call.err)
+	call.value, call.err = attempt.WithRetry(ctx, c.retry, func(ctx context.Context) (V, error) {
+		return c.load(ctx, key)
+	})
+	if call.err == nil {
+		c.cache.Put(key, call.value)
+	}
+}
+
+// Invalidate removes key from the cache and forgets any remembered error for it,
+// so that the next call to Get loads it again.
+func (c *LoadingCache[K, V]) Invalidate(key K) {
+	c.cache.Remove(key)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.negative, key)
+}
+
+// Stats returns a snapshot of the statistics of the LoadingCache.
+// A lookup of a key which is being loaded, or whose load error is remembered, counts as a miss.
+func (c *LoadingCache[K, V]) Stats() Stats {
+	return c.stats.Stats()
+}
+
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
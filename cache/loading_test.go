@@ -0,0 +1,231 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/attempt"
+	"github.com/justenwalker/got/cache"
+	"github.com/justenwalker/got/cache/lru"
+	"github.com/justenwalker/got/clock"
+	"github.com/justenwalker/got/fault"
+)
+
+func ExampleLoadingCache() {
+	users := cache.NewLoading(lru.NewConcurrent[int, string](100), func(_ context.Context, id int) (string, error) {
+		fmt.Println("loading", id)
+		return fmt.Sprintf("user-%d", id), nil
+	})
+	for range 2 {
+		name, _ := users.Get(context.Background(), 1)
+		fmt.Println(name)
+	}
+	// Output:
+	// loading 1
+	// user-1
+	// user-1
+}
+
+func TestLoadingCache_singleflight(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	c := cache.NewLoading(lru.NewConcurrent[string, int](10), func(_ context.Context, key string) (int, error) {
+		calls.Add(1)
+		<-release
+		return len(key), nil
+	})
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := c.Get(context.Background(), "abc"); err != nil || v != 3 {
+				t.Errorf("expected=3, got=%v (err=%v)", v, err)
+			}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected=1 load, got=%v", n)
+	}
+}
+
+func TestLoadingCache_leaderCanceled(t *testing.T) {
+	var calls atomic.Int32
+	started := make(chan struct{})
+	c := cache.NewLoading(lru.NewConcurrent[string, int](10), func(ctx context.Context, key string) (int, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}
+		return len(key), nil
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	leader := make(chan error, 1)
+	go func() {
+		_, err := c.Get(ctx, "abc")
+		leader <- err
+	}()
+	<-started
+	waiter := make(chan error, 1)
+	go func() {
+		v, err := c.Get(context.Background(), "abc")
+		if err == nil && v != 3 {
+			err = fmt.Errorf("expected=3, got=%v", v)
+		}
+		waiter <- err
+	}()
+	for c.Stats().Misses < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	if err := <-leader; !errors.Is(err, context.Canceled) {
+		t.Errorf("expected=%v, got=%v", context.Canceled, err)
+	}
+	if err := <-waiter; err != nil {
+		t.Errorf("expected the waiter to load again, got=%v", err)
+	}
+}
+
+// missOnceCache is a Cache whose first lookup misses, as if the value was stored just after it.
+type missOnceCache struct {
+	cache.Cache[string, int]
+	missed atomic.Bool
+}
+
+func (c *missOnceCache) Get(key string) (int, bool) {
+	if c.missed.CompareAndSwap(false, true) {
+		return 0, false
+	}
+	return c.Cache.Get(key)
+}
+
+func TestLoadingCache_storedAfterMiss(t *testing.T) {
+	store := &missOnceCache{Cache: lru.NewConcurrent[string, int](10)}
+	store.Put("abc", 3)
+	var calls atomic.Int32
+	c := cache.NewLoading[string, int](store, func(_ context.Context, key string) (int, error) {
+		calls.Add(1)
+		return len(key), nil
+	})
+	if v, err := c.Get(context.Background(), "abc"); err != nil || v != 3 {
+		t.Errorf("expected=3, got=%v (err=%v)", v, err)
+	}
+	if n := calls.Load(); n != 0 {
+		t.Errorf("expected=0 loads, got=%v", n)
+	}
+}
+
+func TestLoadingCache_negativeTTL(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	errLoad := errors.New("load failed")
+	var calls int
+	c := cache.NewLoading(lru.NewConcurrent[string, int](10), func(_ context.Context, _ string) (int, error) {
+		calls++
+		return 0, errLoad
+	}, cache.WithNegativeTTL[string, int](time.Minute), cache.WithLoadClock[string, int](clk))
+	tests := []struct {
+		name    string
+		advance time.Duration
+		calls   int
+	}{
+		{name: "first", calls: 1},
+		{name: "remembered", advance: 30 * time.Second, calls: 1},
+		{name: "expired", advance: time.Minute, calls: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk.Advance(tt.advance)
+			if _, err := c.Get(context.Background(), "a"); !errors.Is(err, errLoad) {
+				t.Errorf("expected=%v, got=%v", errLoad, err)
+			}
+			if calls != tt.calls {
+				t.Errorf("expected=%v calls, got=%v", tt.calls, calls)
+			}
+		})
+	}
+	c.Invalidate("a")
+	_, _ = c.Get(context.Background(), "a")
+	if calls != 3 {
+		t.Errorf("expected Invalidate to forget the error")
+	}
+}
+
+func TestLoadingCache_retry(t *testing.T) {
+	var calls int
+	c := cache.NewLoading(lru.NewConcurrent[string, int](10), func(_ context.Context, _ string) (int, error) {
+		calls++
+		if calls < 3 {
+			return 0, errors.New("transient")
+		}
+		return 42, nil
+	}, cache.WithLoadRetry[string, int](attempt.RetryStrategy{ShouldRetry: attempt.RetryAlways}))
+	if v, err := c.Get(context.Background(), "a"); err != nil || v != 42 {
+		t.Errorf("expected=42, got=%v (err=%v)", v, err)
+	}
+	if v, err := c.Get(context.Background(), "a"); err != nil || v != 42 || calls != 3 {
+		t.Errorf("expected cached value, got=%v (err=%v, calls=%v)", v, err, calls)
+	}
+}
+
+func TestLoadingCache_panic(t *testing.T) {
+	var calls atomic.Int32
+	c := cache.NewLoading(lru.NewConcurrent[string, int](10), func(_ context.Context, key string) (int, error) {
+		if calls.Add(1) == 1 {
+			panic("boom")
+		}
+		return len(key), nil
+	})
+	if _, err := c.Get(context.Background(), "abc"); !fault.Has[*fault.PanicError](err) {
+		t.Fatalf("expected a panic error, got=%v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if v, err := c.Get(ctx, "abc"); err != nil || v != 3 {
+		t.Errorf("expected=3, got=%v (err=%v)", v, err)
+	}
+}
+
+func TestLoadingCache_clock(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	c := cache.NewLoading(lru.NewConcurrent[string, int](10), func(_ context.Context, key string) (int, error) {
+		clk.Advance(time.Second)
+		return len(key), nil
+	}, cache.WithLoadClock[string, int](clk))
+	if _, err := c.Get(context.Background(), "abc"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := c.Stats().LoadDuration; got != time.Second {
+		t.Errorf("expected=%v, got=%v", time.Second, got)
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package lru
+
+import (
+	"sync"
+	"time"
+
+	"github.com/justenwalker/got/cache"
+)
+
+// Concurrent is a Cache which is safe for concurrent use.
+type Concurrent[K comparable, V any] struct {
+	mu       sync.Mutex
+	cache    *Cache[K, V]
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewConcurrent creates a Concurrent cache holding up to capacity entries. It panics if capacity is less than 1.
+// Eviction callbacks are called while the cache is locked.
+//
+// If the cache is created WithJanitor, Stop must be called when it is no longer used to stop the janitor goroutine.
+func NewConcurrent[K comparable, V any](capacity int, opts ...Option[K, V]) *Concurrent[K, V] {
+	c := &Concurrent[K, V]{cache: New(capacity, opts...), stop: make(chan struct{})}
+	if interval := c.cache.janitorInterval; interval > 0 {
+		go c.janitor(interval)
+	}
+	return c
+}
+
+func (c *Concurrent[K, V]) janitor(interval time.Duration) {
+	ticker := c.cache.clock.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C():
+			c.RemoveExpired()
+		}
+	}
+}
+
+// Stop stops the janitor goroutine started WithJanitor. The cache can still be used after it is stopped.
+// It is safe to call Stop more than once, or on a cache without a janitor.
+func (c *Concurrent[K, V]) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+}
+
+// Get returns the value for key, and whether it was found, marking the entry as most recently used.
+func (c *Concurrent[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Get(key)
+}
+
+// Peek returns the value for key, and whether it was found, without marking the entry as used.
+func (c *Concurrent[K, V]) Peek(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Peek(key)
+}
+
+// Contains reports whether key is in the cache, without marking the entry as used.
+func (c *Concurrent[K, V]) Contains(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Contains(key)
+}
+
+// Put sets the value for key, marking the entry as most recently used.
+// If the cache is full, the least recently used entry is evicted.
+func (c *Concurrent[K, V]) Put(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Put(key, value)
+}
+
+// PutWithTTL is like Put, but the entry expires after ttl instead of the cache's default time-to-live.
+// A ttl of zero means the entry does not expire.
+func (c *Concurrent[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.PutWithTTL(key, value, ttl)
+}
+
+// GetOrSet returns the existing value for key if it is present. Otherwise, it sets the value for key like Put and returns it.
+// The loaded result is true if the value was present. The check and the update happen atomically.
+func (c *Concurrent[K, V]) GetOrSet(key K, value V) (actual V, loaded bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.GetOrSet(key, value)
+}
+
+// RemoveExpired removes all expired entries from the cache, and returns how many were removed.
+func (c *Concurrent[K, V]) RemoveExpired() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.RemoveExpired()
+}
+
+// Remove removes key from the cache, and reports whether it was present.
+func (c *Concurrent[K, V]) Remove(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Remove(key)
+}
+
+// Stats returns a snapshot of the statistics of the cache. Only Get counts as a lookup; Peek and Contains do not.
+func (c *Concurrent[K, V]) Stats() cache.Stats {
+	return c.cache.Stats()
+}
+
+// Len returns the number of entries in the cache, which may include expired entries that have not been removed yet.
+func (c *Concurrent[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Len()
+}
+
+// Cap returns the maximum number of entries in the cache.
+func (c *Concurrent[K, V]) Cap() int {
+	return c.cache.Cap()
+}
+
+// Keys returns the keys of the entries in the cache which have not expired, from most to least recently used.
+func (c *Concurrent[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.cache.Keys()
+}
+
+// Purge removes all entries from the cache.
+func (c *Concurrent[K, V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cache.Purge()
+}
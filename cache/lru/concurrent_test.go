@@ -0,0 +1,56 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package lru
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestConcurrent(t *testing.T) {
+	c := NewConcurrent[int, int](100)
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				k := (g*1000 + i) % 150
+				c.Put(k, i)
+				c.Get(k)
+				c.Peek(k)
+				c.Contains(k)
+				if i%10 == 0 {
+					c.Remove(k)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if c.Len() > c.Cap() || len(c.Keys()) != c.Len() {
+		t.Errorf("expected at most %v entries, got=%v", c.Cap(), c.Len())
+	}
+	c.Purge()
+	if c.Len() != 0 {
+		t.Errorf("expected Purge to empty the cache")
+	}
+}
@@ -0,0 +1,301 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package lru contains a generic least-recently-used cache, with optional expiration of entries.
+//
+// Cache is not safe for concurrent use; Concurrent wraps it with a mutex,
+// and Sharded splits entries across several Concurrent caches to reduce lock contention.
+// All of them implement the cache.Cache interface.
+package lru
+
+import (
+	"time"
+
+	"github.com/justenwalker/got/cache"
+	"github.com/justenwalker/got/clock"
+)
+
+// Option configures a Cache.
+type Option[K comparable, V any] func(c *Cache[K, V])
+
+// WithOnEvict sets a function to call when an entry is evicted to make room for a new one, or is removed because it expired.
+// It is not called for entries removed with Remove or Purge, or when the value of an existing key is replaced.
+// The function must not call methods on the cache.
+func WithOnEvict[K comparable, V any](fn func(key K, value V)) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.onEvict = fn
+	}
+}
+
+// WithRecorder makes the cache report hits, misses and evictions to r, in addition to counting them for Stats.
+func WithRecorder[K comparable, V any](r cache.Recorder) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.stats.Next = r
+	}
+}
+
+// WithTTL sets the time-to-live of entries added with Put: after ttl has passed, an entry expires
+// and is no longer returned by the cache. A ttl of zero, the default, means entries do not expire.
+//
+// Expired entries are removed lazily when they are accessed, or evicted like any other entry when they become
+// the least recently used entry of a full cache.
+// They can also be removed with RemoveExpired, or in the background by a Concurrent cache using WithJanitor.
+func WithTTL[K comparable, V any](ttl time.Duration) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.ttl = ttl
+	}
+}
+
+// WithClock sets the clock used to expire entries, and to schedule the janitor. By default, it is clock.Real.
+func WithClock[K comparable, V any](clk clock.Clock) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.clock = clk
+		c.now = clk.Now
+	}
+}
+
+// WithJanitor makes a Concurrent cache remove expired entries every interval in a background goroutine,
+// which runs until Stop is called. It has no effect on a Cache created with New.
+func WithJanitor[K comparable, V any](interval time.Duration) Option[K, V] {
+	return func(c *Cache[K, V]) {
+		c.janitorInterval = interval
+	}
+}
+
+// Cache is a cache holding up to a fixed number of entries. When it is full, adding a new entry
+// evicts the least recently used one. Both Get and Put count as using an entry.
+type Cache[K comparable, V any] struct {
+	capacity int
+	entries  map[K]*entry[K, V]
+	// root is a sentinel of a circular doubly linked list, ordered from most to least recently used.
+	root            entry[K, V]
+	onEvict         func(key K, value V)
+	ttl             time.Duration
+	janitorInterval time.Duration
+	clock           clock.Clock
+	now             func() time.Time
+	stats           cache.Counters
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	// expires is the time after which the entry has expired, or the zero Time if it does not expire.
+	expires    time.Time
+	prev, next *entry[K, V]
+}
+
+// New creates a Cache holding up to capacity entries. It panics if capacity is less than 1.
+func New[K comparable, V any](capacity int, opts ...Option[K, V]) *Cache[K, V] {
+	if capacity < 1 {
+		panic("lru: capacity must be at least 1")
+	}
+	c := &Cache[K, V]{
+		capacity: capacity,
+		entries:  make(map[K]*entry[K, V], capacity),
+		clock:    clock.Real(),
+		now:      time.Now,
+	}
+	c.root.next = &c.root
+	c.root.prev = &c.root
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Get returns the value for key, and whether it was found, marking the entry as most recently used.
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	e := c.lookup(key)
+	if e == nil {
+		c.stats.RecordMiss()
+		var zero V
+		return zero, false
+	}
+	c.stats.RecordHit()
+	c.moveToFront(e)
+	return e.value, true
+}
+
+// Peek returns the value for key, and whether it was found, without marking the entry as used.
+func (c *Cache[K, V]) Peek(key K) (V, bool) {
+	e := c.lookup(key)
+	if e == nil {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Contains reports whether key is in the cache, without marking the entry as used.
+func (c *Cache[K, V]) Contains(key K) bool {
+	return c.lookup(key) != nil
+}
+
+// Put sets the value for key, marking the entry as most recently used.
+// If the cache was created WithTTL, the entry expires after that time.
+// If the cache is full, the least recently used entry is evicted.
+func (c *Cache[K, V]) Put(key K, value V) {
+	c.PutWithTTL(key, value, c.ttl)
+}
+
+// PutWithTTL is like Put, but the entry expires after ttl instead of the cache's default time-to-live.
+// A ttl of zero means the entry does not expire.
+func (c *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.now().Add(ttl)
+	}
+	if e, ok := c.entries[key]; ok {
+		e.value = value
+		e.expires = expires
+		c.moveToFront(e)
+		return
+	}
+	if len(c.entries) >= c.capacity {
+		// evicting the least recently used entry keeps Put O(1); expired entries elsewhere in the list
+		// are left to lookup, RemoveExpired and the janitor.
+		c.evict(c.root.prev)
+	}
+	e := &entry[K, V]{key: key, value: value, expires: expires}
+	c.pushFront(e)
+	c.entries[key] = e
+}
+
+// GetOrSet returns the existing value for key if it is present, marking the entry as most recently used.
+// Otherwise, it sets the value for key like Put and returns it. The loaded result is true if the value was present.
+func (c *Cache[K, V]) GetOrSet(key K, value V) (actual V, loaded bool) {
+	if v, ok := c.Get(key); ok {
+		return v, true
+	}
+	c.Put(key, value)
+	return value, false
+}
+
+// RemoveExpired removes all expired entries from the cache, and returns how many were removed.
+func (c *Cache[K, V]) RemoveExpired() int {
+	if len(c.entries) == 0 {
+		return 0
+	}
+	now := c.now()
+	n := 0
+	for e := c.root.next; e != &c.root; {
+		next := e.next
+		if e.expired(now) {
+			c.evict(e)
+			n++
+		}
+		e = next
+	}
+	return n
+}
+
+// lookup returns the entry for key, removing it and returning nil if it has expired.
+func (c *Cache[K, V]) lookup(key K) *entry[K, V] {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil
+	}
+	if e.expired(c.now()) {
+		c.evict(e)
+		return nil
+	}
+	return e
+}
+
+func (c *Cache[K, V]) evict(e *entry[K, V]) {
+	c.unlink(e)
+	delete(c.entries, e.key)
+	c.stats.RecordEviction()
+	if c.onEvict != nil {
+		c.onEvict(e.key, e.value)
+	}
+}
+
+func (e *entry[K, V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && now.After(e.expires)
+}
+
+// Remove removes key from the cache, and reports whether it was present.
+func (c *Cache[K, V]) Remove(key K) bool {
+	e, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	c.unlink(e)
+	delete(c.entries, key)
+	return true
+}
+
+// Stats returns a snapshot of the statistics of the cache. Only Get counts as a lookup; Peek and Contains do not.
+func (c *Cache[K, V]) Stats() cache.Stats {
+	return c.stats.Stats()
+}
+
+// Len returns the number of entries in the cache, which may include expired entries that have not been removed yet.
+func (c *Cache[K, V]) Len() int {
+	return len(c.entries)
+}
+
+// Cap returns the maximum number of entries in the cache.
+func (c *Cache[K, V]) Cap() int {
+	return c.capacity
+}
+
+// Keys returns the keys of the entries in the cache which have not expired, from most to least recently used.
+func (c *Cache[K, V]) Keys() []K {
+	now := c.now()
+	keys := make([]K, 0, len(c.entries))
+	for e := c.root.next; e != &c.root; e = e.next {
+		if !e.expired(now) {
+			keys = append(keys, e.key)
+		}
+	}
+	return keys
+}
+
+// Purge removes all entries from the cache.
+func (c *Cache[K, V]) Purge() {
+	clear(c.entries)
+	c.root.next = &c.root
+	c.root.prev = &c.root
+}
+
+func (c *Cache[K, V]) pushFront(e *entry[K, V]) {
+	e.prev = &c.root
+	e.next = c.root.next
+	c.root.next.prev = e
+	c.root.next = e
+}
+
+func (c *Cache[K, V]) unlink(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next = nil, nil
+}
+
+func (c *Cache[K, V]) moveToFront(e *entry[K, V]) {
+	if c.root.next == e {
+		return
+	}
+	c.unlink(e)
+	c.pushFront(e)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package lru
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/justenwalker/got/cache"
+)
+
+var (
+	_ cache.Cache[string, int] = (*Cache[string, int])(nil)
+	_ cache.Cache[string, int] = (*Concurrent[string, int])(nil)
+	_ cache.Cache[string, int] = (*Sharded[string, int])(nil)
+)
+
+func ExampleCache() {
+	c := New[string, int](2, WithOnEvict(func(k string, v int) {
+		fmt.Println("evicted", k, v)
+	}))
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Put("c", 3)
+	fmt.Println(c.Keys())
+	// Output:
+	// evicted b 2
+	// [c a]
+}
+
+func TestCache(t *testing.T) {
+	var evicted []string
+	c := New(3, WithOnEvict(func(k string, _ int) { evicted = append(evicted, k) }))
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Put("c", 3)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Get: expected=1, got=%v", v)
+	}
+	if v, ok := c.Peek("b"); !ok || v != 2 {
+		t.Errorf("Peek: expected=2, got=%v", v)
+	}
+	c.Put("d", 4) // evicts b, since Peek does not count as use
+	if c.Contains("b") || !slices.Equal(evicted, []string{"b"}) {
+		t.Errorf("expected b to be evicted, got=%v", evicted)
+	}
+	c.Put("c", 30) // replaces without eviction
+	if v, _ := c.Get("c"); v != 30 || len(evicted) != 1 {
+		t.Errorf("expected c=30 without eviction, got=%v (evicted=%v)", v, evicted)
+	}
+	if keys := c.Keys(); !slices.Equal(keys, []string{"c", "d", "a"}) {
+		t.Errorf("expected=[c d a], got=%v", keys)
+	}
+	if !c.Remove("a") || c.Remove("a") || c.Len() != 2 {
+		t.Errorf("expected Remove to report presence")
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("expected a to be removed")
+	}
+	c.Purge()
+	if c.Len() != 0 || len(c.Keys()) != 0 || c.Cap() != 3 {
+		t.Errorf("expected Purge to empty the cache")
+	}
+	c.Put("e", 5)
+	if v, ok := c.Get("e"); !ok || v != 5 {
+		t.Errorf("expected cache to be usable after Purge, got=%v", v)
+	}
+}
+
+func TestNew_invalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+	New[string, int](0)
+}
@@ -0,0 +1,193 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package lru
+
+import (
+	"hash/maphash"
+	"math/bits"
+	"time"
+
+	"github.com/justenwalker/got/cache"
+)
+
+// Sharded is a cache which is safe for concurrent use, made of several Concurrent caches called shards.
+// Each key belongs to one shard, chosen by its hash, so that operations on keys in different shards
+// do not contend for the same lock. This makes it faster than a single Concurrent cache under heavy concurrent use.
+//
+// Entries are evicted per shard: when a shard is full, its least recently used entry is evicted,
+// even if entries in other shards were used less recently.
+type Sharded[K comparable, V any] struct {
+	shards []*Concurrent[K, V]
+	mask   uint64
+	hash   func(K) uint64
+}
+
+// NewSharded creates a Sharded cache holding up to capacity entries, split across the given number of shards.
+// The number of shards is rounded up to a power of two, and capacity is rounded up to a multiple of it.
+// Keys are assigned to shards using hash, which should spread keys uniformly; see StringHash and IntegerHash.
+//
+// The options are applied to every shard. It panics if capacity or shards is less than 1, or hash is nil.
+func NewSharded[K comparable, V any](capacity int, shards int, hash func(K) uint64, opts ...Option[K, V]) *Sharded[K, V] {
+	if capacity < 1 {
+		panic("lru: capacity must be at least 1")
+	}
+	if shards < 1 {
+		panic("lru: shards must be at least 1")
+	}
+	if hash == nil {
+		panic("lru: hash must not be nil")
+	}
+	n := 1 << bits.Len(uint(shards-1))
+	perShard := (capacity + n - 1) / n
+	s := &Sharded[K, V]{
+		shards: make([]*Concurrent[K, V], n),
+		mask:   uint64(n - 1),
+		hash:   hash,
+	}
+	for i := range s.shards {
+		s.shards[i] = NewConcurrent(perShard, opts...)
+	}
+	return s
+}
+
+// StringHash returns a hash function for string keys, for use with NewSharded.
+func StringHash[K ~string]() func(K) uint64 {
+	seed := maphash.MakeSeed()
+	return func(k K) uint64 {
+		return maphash.String(seed, string(k))
+	}
+}
+
+// IntegerHash returns a hash function for integer keys, for use with NewSharded.
+func IntegerHash[K ~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr]() func(K) uint64 {
+	return func(k K) uint64 {
+		// splitmix64 finalizer, so that sequential keys are spread across shards.
+		x := uint64(k)
+		x ^= x >> 30
+		x *= 0xbf58476d1ce4e5b9
+		x ^= x >> 27
+		x *= 0x94d049bb133111eb
+		x ^= x >> 31
+		return x
+	}
+}
+
+func (s *Sharded[K, V]) shard(key K) *Concurrent[K, V] {
+	return s.shards[s.hash(key)&s.mask]
+}
+
+// Get returns the value for key, and whether it was found, marking the entry as most recently used.
+func (s *Sharded[K, V]) Get(key K) (V, bool) {
+	return s.shard(key).Get(key)
+}
+
+// Peek returns the value for key, and whether it was found, without marking the entry as used.
+func (s *Sharded[K, V]) Peek(key K) (V, bool) {
+	return s.shard(key).Peek(key)
+}
+
+// Contains reports whether key is in the cache, without marking the entry as used.
+func (s *Sharded[K, V]) Contains(key K) bool {
+	return s.shard(key).Contains(key)
+}
+
+// Put sets the value for key, marking the entry as most recently used.
+// If the key's shard is full, its least recently used entry is evicted.
+func (s *Sharded[K, V]) Put(key K, value V) {
+	s.shard(key).Put(key, value)
+}
+
+// PutWithTTL is like Put, but the entry expires after ttl instead of the cache's default time-to-live.
+// A ttl of zero means the entry does not expire.
+func (s *Sharded[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	s.shard(key).PutWithTTL(key, value, ttl)
+}
+
+// GetOrSet returns the existing value for key if it is present. Otherwise, it sets the value for key like Put and returns it.
+// The loaded result is true if the value was present. The check and the update happen atomically.
+func (s *Sharded[K, V]) GetOrSet(key K, value V) (actual V, loaded bool) {
+	return s.shard(key).GetOrSet(key, value)
+}
+
+// Remove removes key from the cache, and reports whether it was present.
+func (s *Sharded[K, V]) Remove(key K) bool {
+	return s.shard(key).Remove(key)
+}
+
+// RemoveExpired removes all expired entries from the cache, and returns how many were removed.
+func (s *Sharded[K, V]) RemoveExpired() int {
+	n := 0
+	for _, c := range s.shards {
+		n += c.RemoveExpired()
+	}
+	return n
+}
+
+// Len returns the number of entries in the cache, which may include expired entries that have not been removed yet.
+// Shards are counted one at a time, so the result may not reflect concurrent updates.
+func (s *Sharded[K, V]) Len() int {
+	n := 0
+	for _, c := range s.shards {
+		n += c.Len()
+	}
+	return n
+}
+
+// Cap returns the maximum number of entries in the cache.
+func (s *Sharded[K, V]) Cap() int {
+	return len(s.shards) * s.shards[0].Cap()
+}
+
+// Shards returns the number of shards.
+func (s *Sharded[K, V]) Shards() int {
+	return len(s.shards)
+}
+
+// Purge removes all entries from the cache.
+func (s *Sharded[K, V]) Purge() {
+	for _, c := range s.shards {
+		c.Purge()
+	}
+}
+
+// Stats returns the sum of the statistics of all shards. Only Get counts as a lookup; Peek and Contains do not.
+func (s *Sharded[K, V]) Stats() cache.Stats {
+	var total cache.Stats
+	for _, c := range s.shards {
+		st := c.Stats()
+		total.Hits += st.Hits
+		total.Misses += st.Misses
+		total.Evictions += st.Evictions
+		total.Loads += st.Loads
+		total.LoadErrors += st.LoadErrors
+		total.LoadDuration += st.LoadDuration
+	}
+	return total
+}
+
+// Stop stops the janitor goroutines of all shards, if the cache was created WithJanitor.
+// It is safe to call Stop more than once.
+func (s *Sharded[K, V]) Stop() {
+	for _, c := range s.shards {
+		c.Stop()
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package lru
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestNewSharded(t *testing.T) {
+	tests := []struct {
+		name     string
+		capacity int
+		shards   int
+		expShard int
+		expCap   int
+	}{
+		{name: "one", capacity: 10, shards: 1, expShard: 1, expCap: 10},
+		{name: "power of two", capacity: 64, shards: 8, expShard: 8, expCap: 64},
+		{name: "rounded", capacity: 100, shards: 5, expShard: 8, expCap: 104},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewSharded[int, int](tt.capacity, tt.shards, IntegerHash[int]())
+			if c.Shards() != tt.expShard {
+				t.Errorf("expected=%v shards, got=%v", tt.expShard, c.Shards())
+			}
+			if c.Cap() != tt.expCap {
+				t.Errorf("expected=%v capacity, got=%v", tt.expCap, c.Cap())
+			}
+		})
+	}
+}
+
+func TestSharded(t *testing.T) {
+	c := NewSharded[string, int](64, 4, StringHash[string]())
+	defer c.Stop()
+	var wg sync.WaitGroup
+	for g := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 1000 {
+				k := strconv.Itoa((g*1000 + i) % 100)
+				c.Put(k, i)
+				c.Get(k)
+				c.GetOrSet(k, i)
+				if i%10 == 0 {
+					c.Remove(k)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if c.Len() > c.Cap() {
+		t.Errorf("expected at most %v entries, got=%v", c.Cap(), c.Len())
+	}
+	c.Put("a", 1)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("expected=1, got=%v", v)
+	}
+	if st := c.Stats(); st.Hits == 0 || st.Requests() != 16001 {
+		t.Errorf("expected 16001 lookups, got=%+v", st)
+	}
+	c.Purge()
+	if c.Len() != 0 || c.Contains("a") {
+		t.Errorf("expected Purge to empty the cache")
+	}
+}
+
+func benchmarkParallel(b *testing.B, c interface {
+	Get(key int) (int, bool)
+	Put(key int, value int)
+},
+) {
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			k := i % 2048
+			if _, ok := c.Get(k); !ok {
+				c.Put(k, i)
+			}
+			i++
+		}
+	})
+}
+
+func BenchmarkConcurrent(b *testing.B) {
+	benchmarkParallel(b, NewConcurrent[int, int](1024))
+}
+
+func BenchmarkSharded(b *testing.B) {
+	benchmarkParallel(b, NewSharded[int, int](1024, 16, IntegerHash[int]()))
+}
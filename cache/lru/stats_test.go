@@ -0,0 +1,52 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package lru
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/cache"
+)
+
+func TestCache_Stats(t *testing.T) {
+	now := time.Unix(0, 0)
+	var rec cache.Counters
+	c := NewConcurrent(2, WithRecorder[string, int](&rec))
+	c.cache.now = func() time.Time { return now }
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	c.Get("c")
+	c.Peek("b")
+	c.Put("c", 3) // evicts b
+	c.PutWithTTL("d", 4, time.Second)
+	now = now.Add(time.Minute)
+	c.Get("d") // expired
+	expect := cache.Stats{Hits: 1, Misses: 2, Evictions: 3}
+	if got := c.Stats(); got != expect {
+		t.Errorf("expected=%+v, got=%+v", expect, got)
+	}
+	if got := rec.Stats(); got != expect {
+		t.Errorf("expected recorder to receive events: expected=%+v, got=%+v", expect, got)
+	}
+}
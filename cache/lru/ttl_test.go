@@ -0,0 +1,128 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package lru
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestCache_ttl(t *testing.T) {
+	now := time.Unix(0, 0)
+	var evicted []string
+	c := New(3, WithTTL[string, int](time.Minute), WithOnEvict(func(k string, _ int) {
+		evicted = append(evicted, k)
+	}))
+	c.now = func() time.Time { return now }
+	c.Put("a", 1)
+	c.PutWithTTL("b", 2, time.Hour)
+	c.PutWithTTL("c", 3, 0)
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("expected a to expire")
+	}
+	if !slices.Equal(evicted, []string{"a"}) || c.Len() != 2 {
+		t.Errorf("expected a to be removed lazily, got=%v", evicted)
+	}
+	if v, ok := c.Peek("b"); !ok || v != 2 {
+		t.Errorf("expected=2, got=%v", v)
+	}
+	now = now.Add(2 * time.Hour)
+	if keys := c.Keys(); !slices.Equal(keys, []string{"c"}) {
+		t.Errorf("expected=[c], got=%v", keys)
+	}
+	if c.Len() != 2 {
+		t.Errorf("expected Len to include unremoved expired entries, got=%v", c.Len())
+	}
+	if n := c.RemoveExpired(); n != 1 || c.Len() != 1 {
+		t.Errorf("expected 1 expired entry to be removed, got=%v", n)
+	}
+	if !c.Contains("c") {
+		t.Errorf("expected c not to expire")
+	}
+}
+
+func TestCache_ttlEvictsLeastRecentlyUsed(t *testing.T) {
+	now := time.Unix(0, 0)
+	c := New[string, int](3)
+	c.now = func() time.Time { return now }
+	c.Put("a", 1)
+	c.PutWithTTL("b", 2, time.Second)
+	c.Put("c", 3)
+	now = now.Add(time.Minute)
+	// b has expired, but Put only evicts the least recently used entry rather than searching for expired ones.
+	c.Put("d", 4)
+	if c.Contains("a") {
+		t.Errorf("expected a to be evicted")
+	}
+	if c.Len() != 3 {
+		t.Errorf("expected expired b to remain until it is removed, got Len=%v", c.Len())
+	}
+	if keys := c.Keys(); !slices.Equal(keys, []string{"d", "c"}) {
+		t.Errorf("expected=[d c], got=%v", keys)
+	}
+}
+
+func BenchmarkCache_PutFull(b *testing.B) {
+	for _, size := range []int{100, 100_000} {
+		b.Run(strconv.Itoa(size), func(b *testing.B) {
+			c := New(size, WithTTL[int, int](time.Hour))
+			for i := range size {
+				c.Put(i, i)
+			}
+			b.ResetTimer()
+			for i := range b.N {
+				c.Put(size+i, i)
+			}
+		})
+	}
+}
+
+func TestCache_GetOrSet(t *testing.T) {
+	c := New[string, int](2)
+	if v, loaded := c.GetOrSet("a", 1); loaded || v != 1 {
+		t.Errorf("expected=1 (not loaded), got=%v (loaded=%v)", v, loaded)
+	}
+	if v, loaded := c.GetOrSet("a", 2); !loaded || v != 1 {
+		t.Errorf("expected=1 (loaded), got=%v (loaded=%v)", v, loaded)
+	}
+}
+
+func TestConcurrent_janitor(t *testing.T) {
+	c := NewConcurrent(10, WithTTL[string, int](time.Millisecond), WithJanitor[string, int](time.Millisecond))
+	defer c.Stop()
+	c.Put("a", 1)
+	if v, loaded := c.GetOrSet("b", 2); loaded || v != 2 {
+		t.Errorf("expected=2 (not loaded), got=%v (loaded=%v)", v, loaded)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for c.Len() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected janitor to remove expired entries, got=%v", c.Len())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	c.Stop()
+	c.Stop()
+}
@@ -0,0 +1,132 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package cache
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// Stats is a snapshot of the statistics of a cache.
+type Stats struct {
+	// Hits is the number of lookups which found a value.
+	Hits uint64
+	// Misses is the number of lookups which did not find a value.
+	Misses uint64
+	// Evictions is the number of entries evicted to make room for new ones, or because they expired.
+	Evictions uint64
+	// Loads is the number of times a value was loaded, including failed loads.
+	Loads uint64
+	// LoadErrors is the number of loads which failed.
+	LoadErrors uint64
+	// LoadDuration is the total time spent loading values.
+	LoadDuration time.Duration
+}
+
+// Requests returns the total number of lookups, Hits + Misses.
+func (s Stats) Requests() uint64 {
+	return s.Hits + s.Misses
+}
+
+// HitRatio returns the fraction of lookups which found a value, or 0 if there were no lookups.
+func (s Stats) HitRatio() float64 {
+	if s.Requests() == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Requests())
+}
+
+// Recorder receives cache events as they happen, for example to export them as metrics.
+// Its methods may be called concurrently, and while the cache is locked, so they should return quickly.
+type Recorder interface {
+	// RecordHit is called when a lookup finds a value.
+	RecordHit()
+	// RecordMiss is called when a lookup does not find a value.
+	RecordMiss()
+	// RecordEviction is called when an entry is evicted to make room for a new one, or because it expired.
+	RecordEviction()
+	// RecordLoad is called when a value has been loaded, with the time it took and the error, if the load failed.
+	RecordLoad(d time.Duration, err error)
+}
+
+// Counters is a Recorder which counts events, and reports them with Stats.
+// The zero value is ready to use, and it is safe for concurrent use.
+//
+// A Counters may also forward events to another Recorder: caches use this to keep their own statistics
+// while also reporting to a Recorder provided by the user.
+type Counters struct {
+	// Next, if not nil, receives every event after it is counted.
+	Next Recorder
+
+	hits, misses, evictions, loads, loadErrors atomic.Uint64
+	loadDuration                               atomic.Int64
+}
+
+var _ Recorder = (*Counters)(nil)
+
+// RecordHit implements Recorder.
+func (c *Counters) RecordHit() {
+	c.hits.Add(1)
+	if c.Next != nil {
+		c.Next.RecordHit()
+	}
+}
+
+// RecordMiss implements Recorder.
+func (c *Counters) RecordMiss() {
+	c.misses.Add(1)
+	if c.Next != nil {
+		c.Next.RecordMiss()
+	}
+}
+
+// RecordEviction implements Recorder.
+func (c *Counters) RecordEviction() {
+	c.evictions.Add(1)
+	if c.Next != nil {
+		c.Next.RecordEviction()
+	}
+}
+
+// RecordLoad implements Recorder.
+func (c *Counters) RecordLoad(d time.Duration, err error) {
+	c.loads.Add(1)
+	if err != nil {
+		c.loadErrors.Add(1)
+	}
+	c.loadDuration.Add(int64(d))
+	if c.Next != nil {
+		c.Next.RecordLoad(d, err)
+	}
+}
+
+// Stats returns a snapshot of the counters.
+func (c *Counters) Stats() Stats {
+	return Stats{
+		Hits:         c.hits.Load(),
+		Misses:       c.misses.Load(),
+		Evictions:    c.evictions.Load(),
+		Loads:        c.loads.Load(),
+		LoadErrors:   c.loadErrors.Load(),
+		LoadDuration: time.Duration(c.loadDuration.Load()),
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/cache"
+	"github.com/justenwalker/got/cache/lru"
+)
+
+func TestCounters(t *testing.T) {
+	var next cache.Counters
+	c := cache.Counters{Next: &next}
+	c.RecordHit()
+	c.RecordHit()
+	c.RecordHit()
+	c.RecordMiss()
+	c.RecordEviction()
+	c.RecordLoad(time.Second, nil)
+	c.RecordLoad(2*time.Second, errors.New("failed"))
+	expect := cache.Stats{Hits: 3, Misses: 1, Evictions: 1, Loads: 2, LoadErrors: 1, LoadDuration: 3 * time.Second}
+	if got := c.Stats(); got != expect {
+		t.Errorf("expected=%+v, got=%+v", expect, got)
+	}
+	if got := next.Stats(); got != expect {
+		t.Errorf("expected events to be forwarded: expected=%+v, got=%+v", expect, got)
+	}
+	if got := c.Stats().HitRatio(); got != 0.75 {
+		t.Errorf("expected=0.75, got=%v", got)
+	}
+	if got := (cache.Stats{}).HitRatio(); got != 0 {
+		t.Errorf("expected=0, got=%v", got)
+	}
+}
+
+func TestLoadingCache_Stats(t *testing.T) {
+	var rec cache.Counters
+	c := cache.NewLoading(lru.NewConcurrent[string, int](10), func(_ context.Context, key string) (int, error) {
+		if key == "" {
+			return 0, errors.New("empty key")
+		}
+		return len(key), nil
+	}, cache.WithLoadRecorder[string, int](&rec))
+	for _, key := range []string{"a", "a", "bb", ""} {
+		_, _ = c.Get(context.Background(), key)
+	}
+	got := c.Stats()
+	if got.Hits != 1 || got.Misses != 3 || got.Loads != 3 || got.LoadErrors != 1 {
+		t.Errorf("expected=1 hit, 3 misses, 3 loads, 1 load error, got=%+v", got)
+	}
+	if rec.Stats() != got {
+		t.Errorf("expected recorder to receive events: expected=%+v, got=%+v", got, rec.Stats())
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import (
+	"time"
+
+	"github.com/justenwalker/got/clock"
+)
+
+// Batch groups values received from the input channel into slices.
+//
+// A batch is emitted when it reaches maxSize values, or when maxWait has elapsed since the first value
+// in the batch was received, whichever comes first. If maxWait is 0, batches are only emitted when full.
+// If maxSize is less than 1, it is treated as 1.
+//
+// When the input channel is closed, any partial batch is emitted and the output channel is closed.
+func Batch[T any](in <-chan T, maxSize int, maxWait time.Duration, opts ...TimerOption) <-chan []T {
+	o := newTimerOptions(opts)
+	if maxSize < 1 {
+		maxSize = 1
+	}
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		var (
+			batch []T
+			timer clock.Timer
+			flush <-chan time.Time
+		)
+		emit := func() {
+			if timer != nil {
+				timer.Stop()
+				timer, flush = nil, nil
+			}
+			out <- batch
+			batch = nil
+		}
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if len(batch) > 0 {
+						emit()
+					}
+					return
+				}
+				if batch == nil {
+					batch = make([]T, 0, maxSize)
+					if maxWait > 0 {
+						timer = o.clock.NewTimer(maxWait)
+						flush = timer.C()
+					}
+				}
+				batch = append(batch, v)
+				if len(batch) >= maxSize {
+					emit()
+				}
+			case <-flush:
+				timer, flush = nil, nil
+				emit()
+			}
+		}
+	}()
+	return out
+}
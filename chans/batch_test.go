@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/chans"
+	"github.com/justenwalker/got/clock"
+)
+
+func ExampleBatch() {
+	in := testSliceChan(1, 2, 3, 4, 5, 6, 7)
+	for batch := range chans.Batch(in, 3, time.Second) {
+		fmt.Println(batch)
+	}
+	// Output:
+	// [1 2 3]
+	// [4 5 6]
+	// [7]
+}
+
+func TestBatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []int
+		maxSize int
+		expect  [][]int
+	}{
+		{name: "empty", input: []int{}, maxSize: 2, expect: [][]int{}},
+		{name: "exact", input: []int{1, 2, 3, 4}, maxSize: 2, expect: [][]int{{1, 2}, {3, 4}}},
+		{name: "partial", input: []int{1, 2, 3}, maxSize: 2, expect: [][]int{{1, 2}, {3}}},
+		{name: "zero-size", input: []int{1, 2}, maxSize: 0, expect: [][]int{{1}, {2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := testCollect(chans.Batch(testSliceChan(tt.input...), tt.maxSize, 0))
+			if len(result) != len(tt.expect) {
+				t.Fatalf("expected=%v, got=%v", tt.expect, result)
+			}
+			for i := range result {
+				testSliceEqual(t, tt.expect[i], result[i])
+			}
+		})
+	}
+}
+
+func TestBatch_maxWait(t *testing.T) {
+	in := make(chan int)
+	out := chans.Batch(in, 10, 20*time.Millisecond)
+	go func() {
+		defer close(in)
+		in <- 1
+		in <- 2
+		time.Sleep(100 * time.Millisecond)
+		in <- 3
+	}()
+	result := testCollect(out)
+	if len(result) != 2 {
+		t.Fatalf("expected 2 batches, got=%v", result)
+	}
+	testSliceEqual(t, []int{1, 2}, result[0])
+	testSliceEqual(t, []int{3}, result[1])
+}
+
+func TestBatch_fakeClock(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	in := make(chan int)
+	out := chans.Batch(in, 10, time.Hour, chans.WithClock(clk))
+	in <- 1
+	in <- 2
+	clk.BlockUntil(1)
+	clk.Advance(time.Hour)
+	testSliceEqual(t, []int{1, 2}, <-out)
+	in <- 3
+	close(in)
+	testSliceEqual(t, []int{3}, <-out)
+}
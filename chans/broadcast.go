@@ -0,0 +1,188 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import (
+	"context"
+	"sync"
+
+	"github.com/justenwalker/got/fault"
+)
+
+// ErrClosed is returned when sending to a Broadcast that has been closed,
+// or when receiving from a channel that has been closed.
+const ErrClosed = fault.Message("chans: closed")
+
+// SlowSubscriberPolicy determines what a Broadcast does when a subscriber's buffer is full.
+type SlowSubscriberPolicy int
+
+const (
+	// PolicyBlock blocks the sender until the subscriber has room for the value.
+	PolicyBlock SlowSubscriberPolicy = iota
+	// PolicyDropOldest discards the oldest buffered value to make room for the new value.
+	// If the subscriber is unbuffered, the new value is dropped instead.
+	PolicyDropOldest
+	// PolicyDropNewest discards the new value, keeping the values already buffered.
+	PolicyDropNewest
+)
+
+// Broadcast delivers each value sent to it to every subscriber.
+//
+// Each subscriber receives values on its own channel, buffered according to the Broadcast's buffer size.
+// When a subscriber is not keeping up, the SlowSubscriberPolicy determines whether Send blocks or drops values.
+// Subscribers can choose their own buffer size and policy with SubscribeWith.
+//
+// A Broadcast must be created with NewBroadcast, and is safe for concurrent use.
+type Broadcast[T any] struct {
+	buffer int
+	policy SlowSubscriberPolicy
+	mu     sync.Mutex
+	subs   map[*subscriber[T]]struct{}
+	closed bool
+}
+
+type subscriber[T any] struct {
+	ch     chan T
+	policy SlowSubscriberPolicy
+	done   chan struct{}
+	once   sync.Once
+}
+
+// NewBroadcast creates a new Broadcast which gives each subscriber a channel with the given buffer size,
+// and handles slow subscribers using the given policy.
+func NewBroadcast[T any](buffer int, policy SlowSubscriberPolicy) *Broadcast[T] {
+	return &Broadcast[T]{
+		buffer: buffer,
+		policy: policy,
+		subs:   make(map[*subscriber[T]]struct{}),
+	}
+}
+
+// Subscribe registers a new subscriber and returns the channel on which it receives values,
+// along with a function to unsubscribe. Unsubscribing closes the channel.
+//
+// If the Broadcast is already closed, the returned channel is closed.
+func (b *Broadcast[T]) Subscribe() (<-chan T, func()) {
+	return b.SubscribeWith(b.buffer, b.policy)
+}
+
+// SubscribeWith is like Subscribe, but the subscriber's channel has the given buffer size,
+// and the subscriber is handled using the given policy when it is not keeping up,
+// instead of those of the Broadcast.
+func (b *Broadcast[T]) SubscribeWith(buffer int, policy SlowSubscriberPolicy) (<-chan T, func()) {
+	sub := &subscriber[T]{
+		ch:     make(chan T, buffer),
+		policy: policy,
+		done:   make(chan struct{}),
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(sub.ch)
+		return sub.ch, func() {}
+	}
+	b.subs[sub] = struct{}{}
+	return sub.ch, func() { b.unsubscribe(sub) }
+}
+
+func (b *Broadcast[T]) unsubscribe(sub *subscriber[T]) {
+	// signal a blocked Send to give up on this subscriber before taking the lock.
+	sub.once.Do(func() { close(sub.done) })
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subs[sub]; ok {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// Send delivers v to every current subscriber, according to each subscriber's policy.
+//
+// For subscribers with PolicyBlock, Send waits for each subscriber to accept the value; if the context is done
+// while waiting, Send returns the context error and remaining subscribers do not receive the value.
+// If the Broadcast is closed, ErrClosed is returned.
+func (b *Broadcast[T]) Send(ctx context.Context, v T) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return ErrClosed
+	}
+	for sub := range b.subs {
+		switch sub.policy {
+		case PolicyBlock:
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-sub.done:
+			case sub.ch <- v:
+			}
+		case PolicyDropOldest:
+			sendDropOldest(sub.ch, v)
+		case PolicyDropNewest:
+			select {
+			case sub.ch <- v:
+			default:
+			}
+		}
+	}
+	return nil
+}
+
+func sendDropOldest[T any](ch chan T, v T) {
+	for {
+		select {
+		case ch <- v:
+			return
+		default:
+		}
+		if cap(ch) == 0 {
+			return
+		}
+		// make room by discarding the oldest value, unless the subscriber already took it.
+		select {
+		case <-ch:
+		default:
+		}
+	}
+}
+
+// Subscribers returns the number of current subscribers.
+func (b *Broadcast[T]) Subscribers() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// Close closes all subscriber channels. Subsequent calls to Send return ErrClosed.
+// It is safe to call Close more than once.
+func (b *Broadcast[T]) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for sub := range b.subs {
+		delete(b.subs, sub)
+		close(sub.ch)
+	}
+}
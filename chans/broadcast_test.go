@@ -0,0 +1,146 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/chans"
+)
+
+func ExampleBroadcast() {
+	b := chans.NewBroadcast[string](1, chans.PolicyBlock)
+	sub1, _ := b.Subscribe()
+	sub2, _ := b.Subscribe()
+	_ = b.Send(context.Background(), "hello")
+	fmt.Println(<-sub1)
+	fmt.Println(<-sub2)
+	b.Close()
+	_, ok := <-sub1
+	fmt.Println(ok)
+	// Output:
+	// hello
+	// hello
+	// false
+}
+
+func TestBroadcast_Send_policy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy chans.SlowSubscriberPolicy
+		buffer int
+		expect []int
+	}{
+		{name: "drop-oldest", policy: chans.PolicyDropOldest, buffer: 2, expect: []int{4, 5}},
+		{name: "drop-newest", policy: chans.PolicyDropNewest, buffer: 2, expect: []int{1, 2}},
+		{name: "drop-oldest-unbuffered", policy: chans.PolicyDropOldest, buffer: 0, expect: []int{}},
+		{name: "drop-newest-unbuffered", policy: chans.PolicyDropNewest, buffer: 0, expect: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := chans.NewBroadcast[int](tt.buffer, tt.policy)
+			sub, _ := b.Subscribe()
+			for i := 1; i <= 5; i++ {
+				if err := b.Send(context.Background(), i); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			b.Close()
+			testSliceEqual(t, tt.expect, testCollect(sub))
+		})
+	}
+}
+
+func TestBroadcast_SubscribeWith(t *testing.T) {
+	b := chans.NewBroadcast[int](0, chans.PolicyBlock)
+	oldest, _ := b.SubscribeWith(2, chans.PolicyDropOldest)
+	newest, _ := b.SubscribeWith(2, chans.PolicyDropNewest)
+	if n := b.Subscribers(); n != 2 {
+		t.Errorf("expected=2 subscribers, got=%v", n)
+	}
+	for i := 1; i <= 5; i++ {
+		if err := b.Send(context.Background(), i); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	b.Close()
+	testSliceEqual(t, []int{4, 5}, testCollect(oldest))
+	testSliceEqual(t, []int{1, 2}, testCollect(newest))
+}
+
+func TestBroadcast_Send_block(t *testing.T) {
+	b := chans.NewBroadcast[int](0, chans.PolicyBlock)
+	sub, _ := b.Subscribe()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.Send(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Send() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	go func() {
+		_ = b.Send(context.Background(), 2)
+	}()
+	if v := <-sub; v != 2 {
+		t.Errorf("received %v, want 2", v)
+	}
+}
+
+func TestBroadcast_unsubscribe(t *testing.T) {
+	b := chans.NewBroadcast[int](0, chans.PolicyBlock)
+	sub, unsubscribe := b.Subscribe()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- b.Send(context.Background(), 1)
+	}()
+	// give Send a chance to block on the subscriber
+	time.Sleep(10 * time.Millisecond)
+	unsubscribe()
+	select {
+	case err := <-errCh:
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected Send to return after unsubscribe")
+	}
+	if _, ok := <-sub; ok {
+		t.Fatalf("expected subscriber channel to be closed")
+	}
+	unsubscribe()
+}
+
+func TestBroadcast_Close(t *testing.T) {
+	b := chans.NewBroadcast[int](1, chans.PolicyBlock)
+	b.Close()
+	b.Close()
+	if err := b.Send(context.Background(), 1); !errors.Is(err, chans.ErrClosed) {
+		t.Fatalf("Send() error = %v, want %v", err, chans.ErrClosed)
+	}
+	sub, unsubscribe := b.Subscribe()
+	defer unsubscribe()
+	if _, ok := <-sub; ok {
+		t.Fatalf("expected subscriber channel to be closed")
+	}
+}
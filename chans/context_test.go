@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/chans"
+)
+
+func TestOrDone(t *testing.T) {
+	t.Run("input_closed", func(t *testing.T) {
+		out := chans.OrDone(context.Background(), testSliceChan(1, 2, 3))
+		testSliceEqual(t, []int{1, 2, 3}, testCollect(out))
+	})
+	t.Run("context_done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int)
+		out := chans.OrDone[int](ctx, in)
+		cancel()
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Fatalf("expected output channel to be closed")
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("expected output channel to be closed after context cancellation")
+		}
+	})
+}
+
+func TestRecvContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		ch        <-chan int
+		expect    int
+		expectErr error
+	}{
+		{name: "value", ctx: context.Background(), ch: testSliceChan(1), expect: 1},
+		{name: "closed", ctx: context.Background(), ch: testSliceChan[int](), expectErr: chans.ErrClosed},
+		{name: "cancelled", ctx: cancelled, ch: make(chan int), expectErr: context.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := chans.RecvContext(tt.ctx, tt.ch)
+			if !errors.Is(err, tt.expectErr) {
+				t.Fatalf("RecvContext() error = %v, want %v", err, tt.expectErr)
+			}
+			if v != tt.expect {
+				t.Fatalf("RecvContext() = %v, want %v", v, tt.expect)
+			}
+		})
+	}
+}
+
+func TestSendContext(t *testing.T) {
+	ch := make(chan int, 1)
+	if err := chans.SendContext(context.Background(), ch, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := chans.SendContext(ctx, ch, 2); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("SendContext() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if v := <-ch; v != 1 {
+		t.Fatalf("received %v, want 1", v)
+	}
+}
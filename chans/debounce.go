@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import (
+	"time"
+
+	"github.com/justenwalker/got/clock"
+)
+
+// Debounce emits a value only after the input has been quiet for the duration d.
+// If several values arrive within d of each other, only the last one is emitted.
+//
+// When the input channel is closed, any pending value is emitted and the output channel is closed.
+func Debounce[T any](in <-chan T, d time.Duration, opts ...TimerOption) <-chan T {
+	o := newTimerOptions(opts)
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		var (
+			pending T
+			timer   clock.Timer
+			fire    <-chan time.Time
+		)
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					if fire != nil {
+						timer.Stop()
+						out <- pending
+					}
+					return
+				}
+				pending = v
+				if timer != nil {
+					timer.Stop()
+				}
+				// a fresh timer avoids receiving a stale tick from a timer that fired before it was stopped.
+				timer = o.clock.NewTimer(d)
+				fire = timer.C()
+			case <-fire:
+				fire = nil
+				out <- pending
+			}
+		}
+	}()
+	return out
+}
+
+// Throttle emits at most one value per interval d.
+// The first value is emitted immediately; values received during the following interval are dropped.
+//
+// When the input channel is closed, the output channel is closed.
+func Throttle[T any](in <-chan T, d time.Duration, opts ...TimerOption) <-chan T {
+	o := newTimerOptions(opts)
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		var gate <-chan time.Time
+		for {
+			select {
+			case v, ok := <-in:
+				if !ok {
+					return
+				}
+				if gate != nil {
+					continue
+				}
+				out <- v
+				gate = o.clock.NewTimer(d).C()
+			case <-gate:
+				gate = nil
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/chans"
+	"github.com/justenwalker/got/clock"
+)
+
+func TestDebounce(t *testing.T) {
+	in := make(chan int)
+	out := chans.Debounce(in, 50*time.Millisecond)
+	go func() {
+		defer close(in)
+		// burst: only the last value should be emitted
+		in <- 1
+		in <- 2
+		in <- 3
+		time.Sleep(200 * time.Millisecond)
+		// a value pending when the input closes is flushed
+		in <- 4
+	}()
+	testSliceEqual(t, []int{3, 4}, testCollect(out))
+}
+
+func TestDebounce_empty(t *testing.T) {
+	out := chans.Debounce(testSliceChan[int](), time.Millisecond)
+	testSliceEqual(t, []int{}, testCollect(out))
+}
+
+func TestThrottle(t *testing.T) {
+	in := make(chan int)
+	out := chans.Throttle(in, 100*time.Millisecond)
+	go func() {
+		defer close(in)
+		// burst: only the first value should be emitted
+		in <- 1
+		in <- 2
+		in <- 3
+		time.Sleep(200 * time.Millisecond)
+		in <- 4
+		in <- 5
+	}()
+	testSliceEqual(t, []int{1, 4}, testCollect(out))
+}
+
+func TestDebounce_fakeClock(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	in := make(chan int)
+	out := chans.Debounce(in, time.Hour, chans.WithClock(clk))
+	in <- 1
+	in <- 2
+	in <- 3
+	// the timer may be replaced after a value is received, so advance until the debounced value is emitted.
+	for emitted := false; !emitted; {
+		select {
+		case v := <-out:
+			if v != 3 {
+				t.Errorf("expected=3, got=%v", v)
+			}
+			emitted = true
+		case <-time.After(time.Millisecond):
+			clk.Advance(time.Hour)
+		}
+	}
+	close(in)
+	testSliceEqual(t, []int{}, testCollect(out))
+}
+
+func TestThrottle_fakeClock(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	in := make(chan int)
+	results := make(chan int, 10)
+	go func() {
+		defer close(results)
+		for v := range chans.Throttle(in, time.Hour, chans.WithClock(clk)) {
+			results <- v
+		}
+	}()
+	in <- 1
+	if v := <-results; v != 1 {
+		t.Errorf("expected=1, got=%v", v)
+	}
+	clk.BlockUntil(1)
+	in <- 2 // dropped, since the hour has not passed
+	clk.Advance(time.Hour)
+	// the gate opens once the throttle receives the timer's tick, so send until a value gets through.
+	for i := 3; ; i++ {
+		in <- i
+		select {
+		case v := <-results:
+			if v < 3 {
+				t.Errorf("expected a value sent after the hour, got=%v", v)
+			}
+		case <-time.After(time.Millisecond):
+			continue
+		}
+		break
+	}
+	close(in)
+	for v := range results {
+		t.Errorf("unexpected value: %v", v)
+	}
+}
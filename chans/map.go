@@ -0,0 +1,151 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import (
+	"context"
+	"sync"
+
+	"github.com/justenwalker/got/semaphore"
+)
+
+// Map applies fn to each value received from the input channel using up to n goroutines,
+// and sends the results to the output channel in the same order as the input.
+//
+// The output channel is closed when the input channel is closed and all results have been sent,
+// or when the context is done. If n is less than 1, it is treated as 1.
+func Map[A, B any](ctx context.Context, in <-chan A, n int, fn func(a A) B) <-chan B {
+	return mapOrdered(ctx, in, n, func(a A) (B, bool) {
+		return fn(a), true
+	})
+}
+
+// MapUnordered is like Map, but sends each result as soon as it is ready, regardless of input order.
+func MapUnordered[A, B any](ctx context.Context, in <-chan A, n int, fn func(a A) B) <-chan B {
+	return mapUnordered(ctx, in, n, func(a A) (B, bool) {
+		return fn(a), true
+	})
+}
+
+// Filter evaluates pred for each value received from the input channel using up to n goroutines,
+// and sends the values for which it returns true to the output channel in the same order as the input.
+//
+// The output channel is closed when the input channel is closed and all values have been sent,
+// or when the context is done. If n is less than 1, it is treated as 1.
+func Filter[T any](ctx context.Context, in <-chan T, n int, pred func(t T) bool) <-chan T {
+	return mapOrdered(ctx, in, n, func(t T) (T, bool) {
+		return t, pred(t)
+	})
+}
+
+// FilterUnordered is like Filter, but sends each value as soon as it is evaluated, regardless of input order.
+func FilterUnordered[T any](ctx context.Context, in <-chan T, n int, pred func(t T) bool) <-chan T {
+	return mapUnordered(ctx, in, n, func(t T) (T, bool) {
+		return t, pred(t)
+	})
+}
+
+type mapResult[T any] struct {
+	value T
+	keep  bool
+}
+
+func mapOrdered[A, B any](ctx context.Context, in <-chan A, n int, fn func(a A) (B, bool)) <-chan B {
+	if n < 1 {
+		n = 1
+	}
+	out := make(chan B)
+	sem := semaphore.New(n)
+	// pending holds the result channels of in-flight calls in input order.
+	pending := make(chan chan mapResult[B], n)
+	go func() {
+		defer close(pending)
+		for {
+			a, err := RecvContext(ctx, in)
+			if err != nil {
+				return
+			}
+			if err = sem.Acquire(ctx); err != nil {
+				return
+			}
+			res := make(chan mapResult[B], 1)
+			if err = SendContext(ctx, pending, res); err != nil {
+				sem.Release()
+				return
+			}
+			go func() {
+				defer sem.Release()
+				b, keep := fn(a)
+				res <- mapResult[B]{value: b, keep: keep}
+			}()
+		}
+	}()
+	go func() {
+		defer close(out)
+		for res := range pending {
+			r, err := RecvContext(ctx, res)
+			if err != nil {
+				return
+			}
+			if !r.keep {
+				continue
+			}
+			if err = SendContext(ctx, out, r.value); err != nil {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func mapUnordered[A, B any](ctx context.Context, in <-chan A, n int, fn func(a A) (B, bool)) <-chan B {
+	if n < 1 {
+		n = 1
+	}
+	out := make(chan B)
+	sem := semaphore.New(n)
+	go func() {
+		var wg sync.WaitGroup
+		defer func() {
+			wg.Wait()
+			close(out)
+		}()
+		for {
+			a, err := RecvContext(ctx, in)
+			if err != nil {
+				return
+			}
+			if err = sem.Acquire(ctx); err != nil {
+				return
+			}
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer sem.Release()
+				if b, keep := fn(a); keep {
+					_ = SendContext(ctx, out, b)
+				}
+			}()
+		}
+	}()
+	return out
+}
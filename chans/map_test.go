@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/chans"
+)
+
+func ExampleMap() {
+	ctx := context.Background()
+	in := testSliceChan(1, 2, 3, 4, 5)
+	squares := chans.Map(ctx, in, 3, func(i int) int {
+		return i * i
+	})
+	evens := chans.Filter(ctx, squares, 3, func(i int) bool {
+		return i%2 == 0
+	})
+	for v := range evens {
+		fmt.Println(v)
+	}
+	// Output:
+	// 4
+	// 16
+}
+
+func TestMap(t *testing.T) {
+	input := make([]int, 100)
+	expect := make([]int, 100)
+	for i := range input {
+		input[i] = i
+		expect[i] = i * 2
+	}
+	var running, maxRunning int32
+	out := chans.Map(context.Background(), testSliceChan(input...), 4, func(i int) int {
+		r := atomic.AddInt32(&running, 1)
+		defer atomic.AddInt32(&running, -1)
+		for {
+			m := atomic.LoadInt32(&maxRunning)
+			if r <= m || atomic.CompareAndSwapInt32(&maxRunning, m, r) {
+				break
+			}
+		}
+		// finish out of order to check that ordering is preserved
+		time.Sleep(time.Duration(100-i) * 10 * time.Microsecond)
+		return i * 2
+	})
+	testSliceEqual(t, expect, testCollect(out))
+	if maxRunning > 4 {
+		t.Errorf("expected at most 4 concurrent calls, got %d", maxRunning)
+	}
+}
+
+func TestMapUnordered(t *testing.T) {
+	out := chans.MapUnordered(context.Background(), testSliceChan(1, 2, 3, 4), 2, func(i int) string {
+		return fmt.Sprint(i)
+	})
+	result := testCollect(out)
+	sort.Strings(result)
+	testSliceEqual(t, []string{"1", "2", "3", "4"}, result)
+}
+
+func TestFilter(t *testing.T) {
+	out := chans.Filter(context.Background(), testSliceChan(1, 2, 3, 4, 5, 6), 0, func(i int) bool {
+		return i%2 == 1
+	})
+	testSliceEqual(t, []int{1, 3, 5}, testCollect(out))
+}
+
+func TestFilterUnordered(t *testing.T) {
+	out := chans.FilterUnordered(context.Background(), testSliceChan(1, 2, 3, 4, 5, 6), 3, func(i int) bool {
+		return i%2 == 0
+	})
+	result := testCollect(out)
+	sort.Ints(result)
+	testSliceEqual(t, []int{2, 4, 6}, result)
+}
+
+func TestMap_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan int)
+	out := chans.Map(ctx, in, 2, func(i int) int { return i })
+	cancel()
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatalf("expected output channel to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected output channel to be closed after context cancellation")
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans_test
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/chans"
+)
+
+func ExampleMerge() {
+	a := testSliceChan(1, 2, 3)
+	b := testSliceChan(4, 5, 6)
+	result := testCollect(chans.Merge(a, b))
+	sort.Ints(result)
+	fmt.Println(result)
+	// Output:
+	// [1 2 3 4 5 6]
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name   string
+		inputs [][]int
+		expect []int
+	}{
+		{name: "none", inputs: nil, expect: []int{}},
+		{name: "one", inputs: [][]int{{1, 2, 3}}, expect: []int{1, 2, 3}},
+		{name: "empty", inputs: [][]int{{}, {}}, expect: []int{}},
+		{name: "many", inputs: [][]int{{1}, {2, 3}, {}, {4, 5, 6}}, expect: []int{1, 2, 3, 4, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chs := make([]<-chan int, len(tt.inputs))
+			for i, in := range tt.inputs {
+				chs[i] = testSliceChan(in...)
+			}
+			result := testCollect(chans.Merge(chs...))
+			sort.Ints(result)
+			testSliceEqual(t, tt.expect, result)
+		})
+	}
+}
+
+func TestMergeContext_cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	open := make(chan int)
+	out := chans.MergeContext[int](ctx, open)
+	cancel()
+	select {
+	case _, ok := <-out:
+		if ok {
+			t.Fatalf("expected output channel to be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected output channel to be closed after context cancellation")
+	}
+}
+
+func testSliceChan[T any](vs ...T) <-chan T {
+	ch := make(chan T, len(vs))
+	for _, v := range vs {
+		ch <- v
+	}
+	close(ch)
+	return ch
+}
+
+func testCollect[T any](ch <-chan T) []T {
+	result := []T{}
+	for v := range ch {
+		result = append(result, v)
+	}
+	return result
+}
+
+func testSliceEqual[T comparable](t *testing.T, expected []T, actual []T) {
+	t.Helper()
+	if len(expected) != len(actual) {
+		t.Fatalf("expected=%v, got=%v", expected, actual)
+	}
+	for i := range expected {
+		if expected[i] != actual[i] {
+			t.Fatalf("expected=%v, got=%v", expected, actual)
+		}
+	}
+}
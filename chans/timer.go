@@ -0,0 +1,46 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import "github.com/justenwalker/got/clock"
+
+// TimerOption configures the timer-based helpers Debounce, Throttle and Batch.
+type TimerOption func(*timerOptions)
+
+type timerOptions struct {
+	clock clock.Clock
+}
+
+// WithClock sets the clock used to measure durations. By default, it is clock.Real.
+func WithClock(clk clock.Clock) TimerOption {
+	return func(o *timerOptions) {
+		o.clock = clk
+	}
+}
+
+func newTimerOptions(opts []TimerOption) timerOptions {
+	o := timerOptions{clock: clock.Real()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
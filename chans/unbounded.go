@@ -0,0 +1,151 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans
+
+import "sync"
+
+// minQueueCapacity is the initial capacity of the Queue buffer once the first value is buffered.
+const minQueueCapacity = 16
+
+// Unbounded returns a pair of channels connected by an unbounded buffer.
+// Sends on the first channel never block for long, as values are buffered until they are received on the second.
+// Closing the first channel causes the second to be closed once all buffered values have been received.
+//
+// Use NewQueue instead if you need to inspect the size of the buffer.
+func Unbounded[T any]() (chan<- T, <-chan T) {
+	q := NewQueue[T]()
+	return q.In(), q.Out()
+}
+
+// Queue connects an input channel to an output channel through a growable buffer,
+// so that producers are not slowed down by consumers. This trades back-pressure for memory:
+// if the consumer never catches up, the buffer grows without limit.
+//
+// A Queue must be created with NewQueue, and is safe for concurrent use.
+type Queue[T any] struct {
+	in  chan T
+	out chan T
+
+	mu   sync.Mutex
+	buf  []T
+	head int
+	n    int
+}
+
+// NewQueue creates a new Queue and starts the goroutine that moves values from In to Out.
+// The goroutine exits after In is closed and all buffered values have been received from Out.
+func NewQueue[T any]() *Queue[T] {
+	q := &Queue[T]{
+		in:  make(chan T),
+		out: make(chan T),
+	}
+	go q.run()
+	return q
+}
+
+// In returns the channel on which values are sent to the Queue.
+func (q *Queue[T]) In() chan<- T {
+	return q.in
+}
+
+// Out returns the channel on which values are received from the Queue.
+func (q *Queue[T]) Out() <-chan T {
+	return q.out
+}
+
+// Len returns the number of values currently buffered.
+func (q *Queue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.n
+}
+
+// Cap returns the current capacity of the buffer. The buffer grows as needed.
+func (q *Queue[T]) Cap() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return len(q.buf)
+}
+
+func (q *Queue[T]) run() {
+	defer close(q.out)
+	in := q.in
+	for {
+		var (
+			out  chan T
+			next T
+		)
+		if n, v := q.peek(); n > 0 {
+			out, next = q.out, v
+		}
+		if in == nil && out == nil {
+			return
+		}
+		select {
+		case v, ok := <-in:
+			if !ok {
+				in = nil
+				continue
+			}
+			q.push(v)
+		case out <- next:
+			q.pop()
+		}
+	}
+}
+
+func (q *Queue[T]) peek() (int, T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.n == 0 {
+		var zero T
+		return 0, zero
+	}
+	return q.n, q.buf[q.head]
+}
+
+func (q *Queue[T]) push(v T) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.n == len(q.buf) {
+		size := 2 * len(q.buf)
+		if size < minQueueCapacity {
+			size = minQueueCapacity
+		}
+		buf := make([]T, size)
+		for i := 0; i < q.n; i++ {
+			buf[i] = q.buf[(q.head+i)%len(q.buf)]
+		}
+		q.buf, q.head = buf, 0
+	}
+	q.buf[(q.head+q.n)%len(q.buf)] = v
+	q.n++
+}
+
+func (q *Queue[T]) pop() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var zero T
+	q.buf[q.head] = zero
+	q.head = (q.head + 1) % len(q.buf)
+	q.n--
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package chans_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/chans"
+)
+
+func TestUnbounded(t *testing.T) {
+	in, out := chans.Unbounded[int]()
+	expect := make([]int, 1000)
+	for i := range expect {
+		expect[i] = i
+		// no consumer is running, so this would block if the buffer were bounded.
+		in <- i
+	}
+	close(in)
+	testSliceEqual(t, expect, testCollect(out))
+}
+
+func TestQueue_Len(t *testing.T) {
+	q := chans.NewQueue[int]()
+	for i := 0; i < 20; i++ {
+		q.In() <- i
+	}
+	// the last value sent may not yet be buffered.
+	waitFor(t, func() bool { return q.Len() == 20 })
+	if q.Cap() < 20 {
+		t.Errorf("Cap() = %d, want >= 20", q.Cap())
+	}
+	for i := 0; i < 5; i++ {
+		if v := <-q.Out(); v != i {
+			t.Fatalf("received %d, want %d", v, i)
+		}
+	}
+	waitFor(t, func() bool { return q.Len() == 15 })
+	close(q.In())
+	result := testCollect(q.Out())
+	if len(result) != 15 {
+		t.Errorf("expected 15 remaining values, got %v", result)
+	}
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d, want 0", q.Len())
+	}
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("condition not met before deadline")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
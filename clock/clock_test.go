@@ -0,0 +1,108 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package clock
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// wrapped is a Clock which is not Real, but behaves like it.
+type wrapped struct {
+	Clock
+}
+
+func TestFromContext(t *testing.T) {
+	if _, ok := FromContext(context.Background()).(realClock); !ok {
+		t.Errorf("expected Real clock by default")
+	}
+	c := wrapped{Real()}
+	if got := FromContext(NewContext(context.Background(), c)); got != c {
+		t.Errorf("expected=%v, got=%v", c, got)
+	}
+}
+
+func TestReal(t *testing.T) {
+	c := Real()
+	start := c.Now()
+	c.Sleep(time.Millisecond)
+	if c.Since(start) < time.Millisecond {
+		t.Errorf("expected Sleep to wait")
+	}
+	timer := c.NewTimer(time.Millisecond)
+	<-timer.C()
+	if timer.Stop() {
+		t.Errorf("expected Stop to report that the timer already fired")
+	}
+	ticker := c.NewTicker(time.Millisecond)
+	<-ticker.C()
+	ticker.Reset(time.Millisecond)
+	<-ticker.C()
+	ticker.Stop()
+	fired := make(chan struct{})
+	c.AfterFunc(time.Millisecond, func() { close(fired) })
+	<-fired
+}
+
+func TestSleepContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		d      time.Duration
+		expect error
+	}{
+		{name: "elapsed", ctx: context.Background(), d: time.Millisecond},
+		{name: "zero", ctx: context.Background()},
+		{name: "cancelled", ctx: ctx, d: time.Hour, expect: context.Canceled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SleepContext(tt.ctx, tt.d); !errors.Is(err, tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, err)
+			}
+		})
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	for _, c := range []Clock{Real(), wrapped{Real()}} {
+		ctx, cancel := WithTimeout(NewContext(context.Background(), c), time.Millisecond)
+		<-ctx.Done()
+		if err := ctx.Err(); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("%T: expected=%v, got=%v", c, context.DeadlineExceeded, err)
+		}
+		if _, ok := ctx.Deadline(); !ok {
+			t.Errorf("%T: expected a deadline", c)
+		}
+		cancel()
+
+		ctx, cancel = WithTimeout(NewContext(context.Background(), c), time.Hour)
+		cancel()
+		if err := ctx.Err(); !errors.Is(err, context.Canceled) {
+			t.Errorf("%T: expected=%v, got=%v", c, context.Canceled, err)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package clock
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Fake is a Clock for tests, whose time only changes when Advance or SetTime is called.
+//
+// Timers and tickers created by a Fake fire when the time is moved past their deadline, in order of their deadlines,
+// before Advance or SetTime returns. Functions passed to AfterFunc are called synchronously by Advance or SetTime,
+// rather than in their own goroutine. A Fake is safe for concurrent use.
+//
+// Code under test usually creates its timers in another goroutine; BlockUntil waits for it to do so
+// before the test moves the time forward.
+type Fake struct {
+	mu      sync.Mutex
+	cond    sync.Cond
+	now     time.Time
+	waiters []*fakeTimer
+}
+
+var _ Clock = (*Fake)(nil)
+
+// NewFake creates a Fake clock whose current time is now.
+func NewFake(now time.Time) *Fake {
+	f := &Fake{now: now}
+	f.cond.L = &f.mu
+	return f
+}
+
+// Now returns the current time of the clock.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Since returns the time elapsed since t, according to the clock.
+func (f *Fake) Since(t time.Time) time.Duration {
+	return f.Now().Sub(t)
+}
+
+// Sleep blocks until the clock has been advanced by at least d.
+func (f *Fake) Sleep(d time.Duration) {
+	<-f.NewTimer(d).C()
+}
+
+// NewTimer creates a Timer which fires once the clock has been advanced by at least d.
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	return f.add(&fakeTimer{clock: f, ch: make(chan time.Time, 1)}, d)
+}
+
+// NewTicker creates a Ticker which fires every time the clock is advanced by another period d.
+// It panics if d is not positive.
+func (f *Fake) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("clock: non-positive interval for NewTicker")
+	}
+	return fakeTicker{f.add(&fakeTimer{clock: f, ch: make(chan time.Time, 1), period: d}, d)}
+}
+
+// AfterFunc creates a Timer which calls fn once the clock has been advanced by at least d.
+func (f *Fake) AfterFunc(d time.Duration, fn func()) Timer {
+	return f.add(&fakeTimer{clock: f, fn: fn}, d)
+}
+
+func (f *Fake) add(t *fakeTimer, d time.Duration) *fakeTimer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t.when = f.now.Add(d)
+	f.waiters = append(f.waiters, t)
+	f.cond.Broadcast()
+	if d <= 0 {
+		f.fireLocked()
+	}
+	return t
+}
+
+// Advance moves the time of the clock forward by d, firing the timers and tickers whose deadlines have passed.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setLocked(f.now.Add(d))
+}
+
+// SetTime sets the time of the clock to t, firing the timers and tickers whose deadlines have passed.
+// Moving the time backwards does not fire anything.
+func (f *Fake) SetTime(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.setLocked(t)
+}
+
+// setLocked moves the time to t one deadline at a time, so that each timer observes the time at which it fires.
+func (f *Fake) setLocked(t time.Time) {
+	for {
+		next := f.nextLocked()
+		if next == nil || next.when.After(t) {
+			break
+		}
+		if next.when.After(f.now) {
+			f.now = next.when
+		}
+		f.fireLocked()
+	}
+	f.now = t
+}
+
+// nextLocked returns the waiter with the earliest deadline, or nil if there are none.
+func (f *Fake) nextLocked() *fakeTimer {
+	if len(f.waiters) == 0 {
+		return nil
+	}
+	return slices.MinFunc(f.waiters, func(a, b *fakeTimer) int {
+		return a.when.Compare(b.when)
+	})
+}
+
+// fireLocked fires all waiters whose deadline is not after the current time.
+func (f *Fake) fireLocked() {
+	for {
+		t := f.nextLocked()
+		if t == nil || t.when.After(f.now) {
+			return
+		}
+		if t.period > 0 {
+			t.when = t.when.Add(t.period)
+		} else {
+			f.removeLocked(t)
+		}
+		if t.fn != nil {
+			f.mu.Unlock()
+			t.fn()
+			f.mu.Lock()
+			continue
+		}
+		select {
+		case t.ch <- f.now:
+		default:
+		}
+	}
+}
+
+func (f *Fake) removeLocked(t *fakeTimer) bool {
+	i := slices.Index(f.waiters, t)
+	if i < 0 {
+		return false
+	}
+	f.waiters = slices.Delete(f.waiters, i, i+1)
+	f.cond.Broadcast()
+	return true
+}
+
+// Waiters returns the number of active timers and tickers, including goroutines blocked in Sleep.
+func (f *Fake) Waiters() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.waiters)
+}
+
+// BlockUntil blocks until there are at least n active timers and tickers, including goroutines blocked in Sleep.
+func (f *Fake) BlockUntil(n int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for len(f.waiters) < n {
+		f.cond.Wait()
+	}
+}
+
+// fakeTimer implements Timer for a Fake clock. It is also the state of a fakeTicker, when period is positive.
+type fakeTimer struct {
+	clock  *Fake
+	when   time.Time
+	period time.Duration
+	ch     chan time.Time
+	fn     func()
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.ch
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	t.drain()
+	return t.clock.removeLocked(t)
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	f := t.clock
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t.drain()
+	active := f.removeLocked(t)
+	if t.period > 0 {
+		t.period = d
+	}
+	t.when = f.now.Add(d)
+	f.waiters = append(f.waiters, t)
+	f.cond.Broadcast()
+	if d <= 0 {
+		f.fireLocked()
+	}
+	return active
+}
+
+type fakeTicker struct {
+	t *fakeTimer
+}
+
+func (t fakeTicker) C() <-chan time.Time {
+	return t.t.ch
+}
+
+func (t fakeTicker) Stop() {
+	t.t.Stop()
+}
+
+func (t fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("clock: non-positive interval for Ticker.Reset")
+	}
+	t.t.Reset(d)
+}
+
+// drain discards a pending value from the channel, so that it is not received after Stop or Reset.
+func (t *fakeTimer) drain() {
+	if t.ch == nil {
+		return
+	}
+	select {
+	case <-t.ch:
+	default:
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package clock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+func ExampleFake() {
+	clk := NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	done := make(chan struct{})
+	go func() {
+		clk.Sleep(time.Minute)
+		close(done)
+	}()
+	clk.BlockUntil(1)
+	clk.Advance(time.Minute)
+	<-done
+	fmt.Println(clk.Now().Format(time.TimeOnly))
+	// Output:
+	// 00:01:00
+}
+
+func TestFake_timers(t *testing.T) {
+	start := time.Unix(0, 0)
+	clk := NewFake(start)
+	var fired []string
+	clk.AfterFunc(3*time.Second, func() { fired = append(fired, "c") })
+	clk.AfterFunc(time.Second, func() { fired = append(fired, "a") })
+	stopped := clk.AfterFunc(2*time.Second, func() { fired = append(fired, "b") })
+	timer := clk.NewTimer(2 * time.Second)
+	if clk.Waiters() != 4 {
+		t.Errorf("expected=4 waiters, got=%v", clk.Waiters())
+	}
+	if !stopped.Stop() || stopped.Stop() {
+		t.Errorf("expected Stop to report whether the timer was active")
+	}
+	clk.Advance(time.Second)
+	if !slices.Equal(fired, []string{"a"}) {
+		t.Errorf("expected=[a], got=%v", fired)
+	}
+	select {
+	case <-timer.C():
+		t.Errorf("expected timer not to fire yet")
+	default:
+	}
+	clk.Advance(5 * time.Second)
+	if !slices.Equal(fired, []string{"a", "c"}) {
+		t.Errorf("expected=[a c], got=%v", fired)
+	}
+	if got := <-timer.C(); !got.Equal(start.Add(2 * time.Second)) {
+		t.Errorf("expected timer to fire at its deadline, got=%v", got)
+	}
+	if clk.Since(start) != 6*time.Second || clk.Waiters() != 0 {
+		t.Errorf("expected 6s to pass with no waiters left, got=%v (%v waiters)", clk.Since(start), clk.Waiters())
+	}
+	if timer.Reset(time.Second) {
+		t.Errorf("expected Reset to report the timer was not active")
+	}
+	clk.SetTime(start)
+	clk.SetTime(start.Add(time.Hour))
+	select {
+	case <-timer.C():
+	default:
+		t.Errorf("expected reset timer to fire")
+	}
+}
+
+func TestFake_ticker(t *testing.T) {
+	clk := NewFake(time.Unix(0, 0))
+	ticker := clk.NewTicker(time.Second)
+	for range 3 {
+		clk.Advance(time.Second)
+		<-ticker.C()
+	}
+	clk.Advance(3 * time.Second) // only one tick is kept, like time.Ticker
+	<-ticker.C()
+	select {
+	case <-ticker.C():
+		t.Errorf("expected ticks to be dropped")
+	default:
+	}
+	ticker.Reset(time.Minute)
+	clk.Advance(time.Second)
+	select {
+	case <-ticker.C():
+		t.Errorf("expected ticker to use the new period")
+	default:
+	}
+	clk.Advance(time.Minute)
+	<-ticker.C()
+	ticker.Stop()
+	clk.Advance(time.Hour)
+	select {
+	case <-ticker.C():
+		t.Errorf("expected stopped ticker not to fire")
+	default:
+	}
+}
+
+func TestFake_WithTimeout(t *testing.T) {
+	clk := NewFake(time.Unix(0, 0))
+	ctx, cancel := WithTimeout(NewContext(context.Background(), clk), time.Minute)
+	defer cancel()
+	if d, _ := ctx.Deadline(); !d.Equal(time.Unix(60, 0)) {
+		t.Errorf("expected deadline from the fake clock, got=%v", d)
+	}
+	clk.Advance(59 * time.Second)
+	if ctx.Err() != nil {
+		t.Errorf("expected context not to be done yet")
+	}
+	clk.Advance(time.Second)
+	if err := ctx.Err(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected=%v, got=%v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestFake_WithTimeout_derived(t *testing.T) {
+	clk := NewFake(time.Unix(0, 0))
+	ctx, cancel := WithTimeout(NewContext(context.Background(), clk), time.Minute)
+	defer cancel()
+	child, cancelChild := context.WithCancel(ctx)
+	defer cancelChild()
+	nested, cancelNested := WithTimeout(child, time.Hour)
+	defer cancelNested()
+	if d, _ := nested.Deadline(); !d.Equal(time.Unix(60, 0)) {
+		t.Errorf("expected the deadline of the parent, got=%v", d)
+	}
+	clk.Advance(time.Minute)
+	for _, c := range []context.Context{child, nested} {
+		select {
+		case <-c.Done():
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected derived context to be done")
+		}
+		if err := c.Err(); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected=%v, got=%v", context.DeadlineExceeded, err)
+		}
+		if err := context.Cause(c); !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("cause expected=%v, got=%v", context.DeadlineExceeded, err)
+		}
+	}
+}
+
+func TestFake_WithTimeout_realDeadline(t *testing.T) {
+	clk := NewFake(time.Unix(0, 0))
+	parent, cancelParent := context.WithTimeout(NewContext(context.Background(), clk), time.Hour)
+	defer cancelParent()
+	ctx, cancel := WithTimeout(parent, 2*time.Hour)
+	defer cancel()
+	d, _ := ctx.Deadline()
+	if d.Before(time.Unix(59*60, 0)) || d.After(time.Unix(60*60, 0)) {
+		t.Errorf("expected the real deadline of the parent in the time of the fake clock, got=%v", d)
+	}
+	cancelParent()
+	<-ctx.Done()
+	if err := ctx.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected=%v, got=%v", context.Canceled, err)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package clock
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WithTimeout is like context.WithTimeout, but the timeout is measured by the Clock carried by ctx.
+// When the timeout elapses, the returned context, and every context derived from it, is done
+// and its Err method returns context.DeadlineExceeded.
+//
+// Unless the Clock is Real, the deadline reported by the returned context is in the time of the Clock.
+func WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	c := FromContext(ctx)
+	if _, ok := c.(realClock); ok {
+		return context.WithTimeout(ctx, timeout)
+	}
+	tc := &timeoutCtx{Context: ctx, deadline: deadlineOf(ctx, c, timeout), done: make(chan struct{})}
+	if err := ctx.Err(); err != nil {
+		tc.cancel(err)
+		return tc, func() {}
+	}
+	stop := context.AfterFunc(ctx, func() {
+		tc.cancel(ctx.Err())
+	})
+	timer := c.AfterFunc(timeout, func() {
+		tc.cancel(context.DeadlineExceeded)
+	})
+	return tc, func() {
+		stop()
+		timer.Stop()
+		tc.cancel(context.Canceled)
+	}
+}
+
+// deadlineOf returns the deadline, in the time of clk, of a context derived from ctx with the given timeout.
+func deadlineOf(ctx context.Context, clk Clock, timeout time.Duration) time.Time {
+	now := clk.Now()
+	deadline := now.Add(timeout)
+	parent, ok := ctx.Deadline()
+	if !ok {
+		return deadline
+	}
+	if tc, isTimeout := ctx.Value(timeoutCtxKey{}).(*timeoutCtx); !isTimeout || !tc.deadline.Equal(parent) {
+		// the deadline of the parent was not set by WithTimeout, so it is in real time.
+		parent = now.Add(time.Until(parent))
+	}
+	if parent.Before(deadline) {
+		return parent
+	}
+	return deadline
+}
+
+type timeoutCtxKey struct{}
+
+// timeoutCtx is a context whose deadline is measured by a Clock other than Real.
+//
+// It has its own done channel rather than wrapping a context created by context.WithCancel,
+// so that contexts derived from it observe its error, rather than context.Canceled.
+type timeoutCtx struct {
+	context.Context
+	deadline time.Time
+	done     chan struct{}
+
+	mu  sync.Mutex
+	err error
+}
+
+func (c *timeoutCtx) cancel(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return
+	}
+	c.err = err
+	close(c.done)
+}
+
+func (c *timeoutCtx) Deadline() (time.Time, bool) {
+	return c.deadline, true
+}
+
+func (c *timeoutCtx) Done() <-chan struct{} {
+	return c.done
+}
+
+func (c *timeoutCtx) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+func (c *timeoutCtx) Value(key any) any {
+	if key == (timeoutCtxKey{}) {
+		return c
+	}
+	return c.Context.Value(key)
+}
@@ -0,0 +1,205 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package deque contains a generic double-ended queue, and Stack and Queue types built on it.
+// All operations are amortized O(1), and the underlying storage is reused as values are added and removed.
+package deque
+
+import "iter"
+
+// minCapacity is the initial capacity of a Deque's buffer. It must be a power of two.
+const minCapacity = 8
+
+// Deque is a double-ended queue, supporting adding and removing values at both ends.
+// The zero value is an empty Deque ready to use. A Deque is not safe for concurrent use.
+type Deque[T any] struct {
+	// buf is a ring buffer whose length is always a power of two, so indexes can be masked.
+	buf  []T
+	head int
+	size int
+}
+
+// Len returns the number of values in the Deque.
+func (d *Deque[T]) Len() int {
+	return d.size
+}
+
+// PushBack adds v to the back of the Deque.
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[(d.head+d.size)&(len(d.buf)-1)] = v
+	d.size++
+}
+
+// PushFront adds v to the front of the Deque.
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = (d.head - 1) & (len(d.buf) - 1)
+	d.buf[d.head] = v
+	d.size++
+}
+
+// PopFront removes and returns the value at the front of the Deque. It returns false if the Deque is empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	v := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) & (len(d.buf) - 1)
+	d.size--
+	d.shrink()
+	return v, true
+}
+
+// PopBack removes and returns the value at the back of the Deque. It returns false if the Deque is empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zero T
+	if d.size == 0 {
+		return zero, false
+	}
+	i := (d.head + d.size - 1) & (len(d.buf) - 1)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.size--
+	d.shrink()
+	return v, true
+}
+
+// Front returns the value at the front of the Deque without removing it. It returns false if the Deque is empty.
+func (d *Deque[T]) Front() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// Back returns the value at the back of the Deque without removing it. It returns false if the Deque is empty.
+func (d *Deque[T]) Back() (T, bool) {
+	if d.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[(d.head+d.size-1)&(len(d.buf)-1)], true
+}
+
+// At returns the value at index i, counting from the front. It panics if i is out of range.
+func (d *Deque[T]) At(i int) T {
+	if i < 0 || i >= d.size {
+		panic("deque: index out of range")
+	}
+	return d.buf[(d.head+i)&(len(d.buf)-1)]
+}
+
+// Clear removes all values from the Deque.
+func (d *Deque[T]) Clear() {
+	clear(d.buf)
+	d.head, d.size = 0, 0
+}
+
+// All returns a sequence of the values in the Deque, from front to back.
+// The Deque must not be modified during iteration.
+func (d *Deque[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range d.size {
+			if !yield(d.buf[(d.head+i)&(len(d.buf)-1)]) {
+				return
+			}
+		}
+	}
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.buf) {
+		return
+	}
+	d.resize(max(minCapacity, len(d.buf)*2))
+}
+
+// shrink halves the buffer when it is at most a quarter full, so a Deque that was briefly large does not hold on to memory.
+func (d *Deque[T]) shrink() {
+	if len(d.buf) > minCapacity && d.size <= len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+func (d *Deque[T]) resize(n int) {
+	buf := make([]T, n)
+	for i := range d.size {
+		buf[i] = d.buf[(d.head+i)&(len(d.buf)-1)]
+	}
+	d.buf = buf
+	d.head = 0
+}
+
+// Stack is a last-in, first-out collection.
+// The zero value is an empty Stack ready to use.
+type Stack[T any] struct {
+	d Deque[T]
+}
+
+// Push adds v to the top of the Stack.
+func (s *Stack[T]) Push(v T) {
+	s.d.PushBack(v)
+}
+
+// Pop removes and returns the value at the top of the Stack. It returns false if the Stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	return s.d.PopBack()
+}
+
+// Peek returns the value at the top of the Stack without removing it. It returns false if the Stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	return s.d.Back()
+}
+
+// Len returns the number of values in the Stack.
+func (s *Stack[T]) Len() int {
+	return s.d.Len()
+}
+
+// Queue is a first-in, first-out collection.
+// The zero value is an empty Queue ready to use.
+type Queue[T any] struct {
+	d Deque[T]
+}
+
+// Push adds v to the back of the Queue.
+func (q *Queue[T]) Push(v T) {
+	q.d.PushBack(v)
+}
+
+// Pop removes and returns the value at the front of the Queue. It returns false if the Queue is empty.
+func (q *Queue[T]) Pop() (T, bool) {
+	return q.d.PopFront()
+}
+
+// Peek returns the value at the front of the Queue without removing it. It returns false if the Queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.d.Front()
+}
+
+// Len returns the number of values in the Queue.
+func (q *Queue[T]) Len() int {
+	return q.d.Len()
+}
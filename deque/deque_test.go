@@ -0,0 +1,139 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package deque
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	if _, ok := d.PopFront(); ok {
+		t.Errorf("expected empty PopFront to fail")
+	}
+	if _, ok := d.PopBack(); ok {
+		t.Errorf("expected empty PopBack to fail")
+	}
+	if _, ok := d.Front(); ok {
+		t.Errorf("expected empty Front to fail")
+	}
+	if _, ok := d.Back(); ok {
+		t.Errorf("expected empty Back to fail")
+	}
+	for i := range 10 {
+		d.PushBack(i)
+		d.PushFront(-i - 1)
+	}
+	expect := []int{-10, -9, -8, -7, -6, -5, -4, -3, -2, -1, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	if actual := slices.Collect(d.All()); !slices.Equal(actual, expect) {
+		t.Errorf("expected=%v, got=%v", expect, actual)
+	}
+	if d.At(10) != 0 || d.Len() != 20 {
+		t.Errorf("expected At(10)=0 and Len=20, got=%v and %v", d.At(10), d.Len())
+	}
+	if v, _ := d.Front(); v != -10 {
+		t.Errorf("Front: expected=-10, got=%v", v)
+	}
+	if v, _ := d.Back(); v != 9 {
+		t.Errorf("Back: expected=9, got=%v", v)
+	}
+	for i := range 10 {
+		if v, ok := d.PopBack(); !ok || v != 9-i {
+			t.Errorf("PopBack: expected=%v, got=%v", 9-i, v)
+		}
+		if v, ok := d.PopFront(); !ok || v != -10+i {
+			t.Errorf("PopFront: expected=%v, got=%v", -10+i, v)
+		}
+	}
+	if d.Len() != 0 {
+		t.Errorf("expected empty deque, got len=%v", d.Len())
+	}
+}
+
+func TestDeque_growShrink(t *testing.T) {
+	var d Deque[int]
+	for i := range 1000 {
+		d.PushBack(i)
+	}
+	for i := range 990 {
+		if v, _ := d.PopFront(); v != i {
+			t.Fatalf("expected=%v, got=%v", i, v)
+		}
+	}
+	if len(d.buf) > 64 {
+		t.Errorf("expected buffer to shrink, got cap=%v", len(d.buf))
+	}
+	if actual := slices.Collect(d.All()); !slices.Equal(actual, []int{990, 991, 992, 993, 994, 995, 996, 997, 998, 999}) {
+		t.Errorf("unexpected contents: %v", actual)
+	}
+	d.Clear()
+	if d.Len() != 0 {
+		t.Errorf("expected Clear to empty the deque")
+	}
+}
+
+func TestDeque_atPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+	var d Deque[int]
+	d.At(0)
+}
+
+func TestStack(t *testing.T) {
+	var s Stack[string]
+	s.Push("a")
+	s.Push("b")
+	if v, ok := s.Peek(); !ok || v != "b" {
+		t.Errorf("Peek: expected=b, got=%v", v)
+	}
+	if v, _ := s.Pop(); v != "b" {
+		t.Errorf("Pop: expected=b, got=%v", v)
+	}
+	if v, _ := s.Pop(); v != "a" {
+		t.Errorf("Pop: expected=a, got=%v", v)
+	}
+	if _, ok := s.Pop(); ok || s.Len() != 0 {
+		t.Errorf("expected empty stack")
+	}
+}
+
+func TestQueue(t *testing.T) {
+	var q Queue[string]
+	q.Push("a")
+	q.Push("b")
+	if v, ok := q.Peek(); !ok || v != "a" {
+		t.Errorf("Peek: expected=a, got=%v", v)
+	}
+	if v, _ := q.Pop(); v != "a" {
+		t.Errorf("Pop: expected=a, got=%v", v)
+	}
+	if v, _ := q.Pop(); v != "b" {
+		t.Errorf("Pop: expected=b, got=%v", v)
+	}
+	if _, ok := q.Pop(); ok || q.Len() != 0 {
+		t.Errorf("expected empty queue")
+	}
+}
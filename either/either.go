@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package either contains an Either type, which holds one of two values of different types.
+//
+// Either is useful for APIs that return one of two distinct successful shapes,
+// where an error is not appropriate and an optional value would lose information.
+package either
+
+// Either holds either a Left value of type L, or a Right value of type R.
+// The zero value of an Either is Left with the zero value of L.
+type Either[L any, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left creates an Either holding the Left value l.
+func Left[L any, R any](l L) Either[L, R] {
+	return Either[L, R]{left: l}
+}
+
+// Right creates an Either holding the Right value r.
+func Right[L any, R any](r R) Either[L, R] {
+	return Either[L, R]{right: r, isRight: true}
+}
+
+// IsLeft reports whether the Either holds a Left value.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight reports whether the Either holds a Right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// GetLeft returns the Left value, and whether the Either holds one.
+func (e Either[L, R]) GetLeft() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// GetRight returns the Right value, and whether the Either holds one.
+func (e Either[L, R]) GetRight() (R, bool) {
+	return e.right, e.isRight
+}
+
+// Swap returns an Either with the Left and Right values exchanged.
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
+}
+
+// MapLeft applies mapFn to the Left value of e, if it holds one. A Right value is returned unchanged.
+func MapLeft[L any, R any, L2 any](e Either[L, R], mapFn func(l L) L2) Either[L2, R] {
+	if e.isRight {
+		return Right[L2](e.right)
+	}
+	return Left[L2, R](mapFn(e.left))
+}
+
+// MapRight applies mapFn to the Right value of e, if it holds one. A Left value is returned unchanged.
+func MapRight[L any, R any, R2 any](e Either[L, R], mapFn func(r R) R2) Either[L, R2] {
+	if e.isRight {
+		return Right[L](mapFn(e.right))
+	}
+	return Left[L, R2](e.left)
+}
+
+// Fold returns the result of onLeft or onRight, depending on which value e holds.
+func Fold[L any, R any, T any](e Either[L, R], onLeft func(l L) T, onRight func(r R) T) T {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
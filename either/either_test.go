@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package either
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func ExampleFold() {
+	parse := func(s string) Either[int, string] {
+		if n, err := strconv.Atoi(s); err == nil {
+			return Left[int, string](n)
+		}
+		return Right[int](s)
+	}
+	describe := func(e Either[int, string]) string {
+		return Fold(e,
+			func(n int) string { return fmt.Sprintf("number %d", n) },
+			func(s string) string { return fmt.Sprintf("name %q", s) },
+		)
+	}
+	fmt.Println(describe(parse("42")))
+	fmt.Println(describe(parse("answer")))
+	// Output:
+	// number 42
+	// name "answer"
+}
+
+func TestEither(t *testing.T) {
+	tests := []struct {
+		name        string
+		either      Either[int, string]
+		expectRight bool
+		expectLeft  int
+		expectR     string
+	}{
+		{name: "zero", either: Either[int, string]{}, expectRight: false},
+		{name: "left", either: Left[int, string](1), expectRight: false, expectLeft: 1},
+		{name: "right", either: Right[int]("a"), expectRight: true, expectR: "a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.either.IsRight() != tt.expectRight || tt.either.IsLeft() == tt.expectRight {
+				t.Errorf("expectRight=%v, got=%v", tt.expectRight, tt.either.IsRight())
+			}
+			l, lok := tt.either.GetLeft()
+			r, rok := tt.either.GetRight()
+			if lok == rok || rok != tt.expectRight {
+				t.Errorf("expected exactly one value, got left=%v right=%v", lok, rok)
+			}
+			if l != tt.expectLeft || r != tt.expectR {
+				t.Errorf("expected=(%v,%q), got=(%v,%q)", tt.expectLeft, tt.expectR, l, r)
+			}
+			s := tt.either.Swap()
+			if s.IsLeft() != tt.expectRight {
+				t.Errorf("expected Swap to exchange sides")
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	l := MapRight(MapLeft(Left[int, string](2), strconv.Itoa), func(s string) int { return len(s) })
+	if v, ok := l.GetLeft(); !ok || v != "2" {
+		t.Errorf("expected=Left(2), got=%v", l)
+	}
+	r := MapLeft(MapRight(Right[int]("abc"), func(s string) int { return len(s) }), strconv.Itoa)
+	if v, ok := r.GetRight(); !ok || v != 3 {
+		t.Errorf("expected=Right(3), got=%v", r)
+	}
+}
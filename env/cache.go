@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import "sync"
+
+// CachedSource is a Source that looks up each variable in another Source at most once,
+// serving subsequent lookups from memory until it is invalidated.
+// Lookups of variables that are not set are cached as well.
+// It is safe for concurrent use.
+type CachedSource struct {
+	src   Source
+	mu    sync.RWMutex
+	cache map[string]cachedValue
+}
+
+type cachedValue struct {
+	value string
+	ok    bool
+}
+
+// Cache returns a CachedSource wrapping src. If src is nil, it wraps the process environment.
+// Using a CachedSource makes the configuration immutable after it is first read, and avoids repeated
+// calls to os.Getenv on hot paths.
+func Cache(src Source) *CachedSource {
+	if src == nil {
+		src = osSource{}
+	}
+	return &CachedSource{src: src, cache: make(map[string]cachedValue)}
+}
+
+// Lookup returns the cached value of key, looking it up in the wrapped Source the first time.
+func (c *CachedSource) Lookup(key string) (string, bool) {
+	c.mu.RLock()
+	cv, ok := c.cache[key]
+	c.mu.RUnlock()
+	if ok {
+		return cv.value, cv.ok
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if cv, ok := c.cache[key]; ok {
+		return cv.value, cv.ok
+	}
+	v, ok := c.src.Lookup(key)
+	c.cache[key] = cachedValue{value: v, ok: ok}
+	return v, ok
+}
+
+// Invalidate removes the given keys from the cache, so that they are looked up again.
+// With no keys, the whole cache is cleared.
+func (c *CachedSource) Invalidate(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(keys) == 0 {
+		clear(c.cache)
+		return
+	}
+	for _, key := range keys {
+		delete(c.cache, key)
+	}
+}
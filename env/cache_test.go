@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"sync"
+	"testing"
+)
+
+type testCountingSource struct {
+	mu    sync.Mutex
+	src   Map
+	count map[string]int
+}
+
+func (s *testCountingSource) Lookup(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.count[key]++
+	return s.src.Lookup(key)
+}
+
+func TestCache(t *testing.T) {
+	src := &testCountingSource{src: Map{"A": "1"}, count: map[string]int{}}
+	c := Cache(src)
+	for range 3 {
+		if v, ok := c.Lookup("A"); !ok || v != "1" {
+			t.Errorf("expected=1, got=%v", v)
+		}
+		if _, ok := c.Lookup("MISSING"); ok {
+			t.Errorf("expected MISSING to be unset")
+		}
+	}
+	if src.count["A"] != 1 || src.count["MISSING"] != 1 {
+		t.Errorf("expected one lookup each, got=%v", src.count)
+	}
+	src.src["A"] = "2"
+	if v, _ := c.Lookup("A"); v != "1" {
+		t.Errorf("expected cached=1, got=%v", v)
+	}
+	c.Invalidate("A")
+	if v, _ := c.Lookup("A"); v != "2" {
+		t.Errorf("expected=2 after Invalidate, got=%v", v)
+	}
+	src.src["MISSING"] = "now"
+	c.Invalidate()
+	if v, _ := c.Lookup("MISSING"); v != "now" {
+		t.Errorf("expected=now after Invalidate, got=%v", v)
+	}
+}
+
+func TestCache_concurrent(t *testing.T) {
+	src := &testCountingSource{src: Map{"A": "1"}, count: map[string]int{}}
+	c := Cache(src)
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Lookup("A")
+		}()
+	}
+	wg.Wait()
+	if src.count["A"] != 1 {
+		t.Errorf("expected one lookup, got=%v", src.count["A"])
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"errors"
+	"strings"
+)
+
+// GetSlice returns the value of an environment variable split by sep, with surrounding whitespace trimmed
+// from each element. It returns nil if the variable is not set or empty.
+//
+//	// HOSTS="a.example.com, b.example.com"
+//	hosts := env.GetSlice("HOSTS", ",") // []string{"a.example.com", "b.example.com"}
+func (e *Env) GetSlice(key string, sep string) []string {
+	v := e.GetWithDefault(key, "")
+	if v == "" {
+		return nil
+	}
+	parts := strings.Split(v, sep)
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+	}
+	return parts
+}
+
+// GetMap returns the value of an environment variable parsed as comma-separated key=value pairs,
+// such as "k1=v1,k2=v2", with surrounding whitespace trimmed from each key and value.
+// It returns nil if the variable is not set or empty, and a *ParseError if a pair has no '='.
+func (e *Env) GetMap(key string) (map[string]string, error) {
+	return GetMapAsIn(e, key, parseString, parseString)
+}
+
+// GetSlice is like Env.GetSlice, reading the process environment.
+func GetSlice(key string, sep string) []string {
+	return std.GetSlice(key, sep)
+}
+
+// GetMap is like Env.GetMap, reading the process environment.
+func GetMap(key string) (map[string]string, error) {
+	return std.GetMap(key)
+}
+
+// GetSliceAs is like GetSlice, converting each element with parse.
+// If any element cannot be parsed, it returns a *ParseError.
+func GetSliceAs[T any](key string, sep string, parse func(string) (T, error)) ([]T, error) {
+	return GetSliceAsIn(std, key, sep, parse)
+}
+
+// GetSliceAsIn is like GetSliceAs, reading the variable from e.
+func GetSliceAsIn[T any](e *Env, key string, sep string, parse func(string) (T, error)) ([]T, error) {
+	parts := e.GetSlice(key, sep)
+	if parts == nil {
+		return nil, nil
+	}
+	result := make([]T, len(parts))
+	for i, part := range parts {
+		v, err := parse(part)
+		if err != nil {
+			return nil, &ParseError{Key: key, Value: part, Err: err}
+		}
+		result[i] = v
+	}
+	return result, nil
+}
+
+// GetMapAs is like GetMap, converting each key with parseKey and each value with parseValue.
+// If any key or value cannot be parsed, it returns a *ParseError.
+func GetMapAs[K comparable, V any](key string, parseKey func(string) (K, error), parseValue func(string) (V, error)) (map[K]V, error) {
+	return GetMapAsIn(std, key, parseKey, parseValue)
+}
+
+// GetMapAsIn is like GetMapAs, reading the variable from e.
+func GetMapAsIn[K comparable, V any](e *Env, key string, parseKey func(string) (K, error), parseValue func(string) (V, error)) (map[K]V, error) {
+	pairs := e.GetSlice(key, ",")
+	if pairs == nil {
+		return nil, nil
+	}
+	result := make(map[K]V, len(pairs))
+	for _, pair := range pairs {
+		ks, vs, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, &ParseError{Key: key, Value: pair, Err: errMissingEquals}
+		}
+		k, err := parseKey(strings.TrimSpace(ks))
+		if err != nil {
+			return nil, &ParseError{Key: key, Value: ks, Err: err}
+		}
+		v, err := parseValue(strings.TrimSpace(vs))
+		if err != nil {
+			return nil, &ParseError{Key: key, Value: vs, Err: err}
+		}
+		result[k] = v
+	}
+	return result, nil
+}
+
+var errMissingEquals = errors.New("expected key=value")
+
+func parseString(s string) (string, error) {
+	return s, nil
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func TestGetSlice(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		sep    string
+		expect []string
+	}{
+		{name: "empty", value: "", sep: ",", expect: nil},
+		{name: "single", value: "a", sep: ",", expect: []string{"a"}},
+		{name: "trimmed", value: " a, b ,c ", sep: ",", expect: []string{"a", "b", "c"}},
+		{name: "colon", value: "/bin:/usr/bin", sep: ":", expect: []string{"/bin", "/usr/bin"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := New(Map{"LIST": tt.value}).GetSlice("LIST", tt.sep)
+			if fmt.Sprintf("%q", actual) != fmt.Sprintf("%q", tt.expect) {
+				t.Errorf("expected=%q, got=%q", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestGetSliceAsIn(t *testing.T) {
+	e := New(Map{"PORTS": "80, 443", "BAD": "80,x"})
+	actual, err := GetSliceAsIn(e, "PORTS", ",", strconv.Atoi)
+	if err != nil || fmt.Sprint(actual) != "[80 443]" {
+		t.Errorf("expected=[80 443], got=%v (err=%v)", actual, err)
+	}
+	_, err = GetSliceAsIn(e, "BAD", ",", strconv.Atoi)
+	testCheckErr(t, err, &ParseError{})
+	actual, err = GetSliceAsIn(e, "MISSING", ",", strconv.Atoi)
+	if err != nil || actual != nil {
+		t.Errorf("expected=nil, got=%v (err=%v)", actual, err)
+	}
+}
+
+func TestGetMap(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		expect    map[string]string
+		expectErr bool
+	}{
+		{name: "empty", value: "", expect: nil},
+		{name: "pairs", value: "k1=v1, k2 = v2", expect: map[string]string{"k1": "v1", "k2": "v2"}},
+		{name: "equals-in-value", value: "q=a=b", expect: map[string]string{"q": "a=b"}},
+		{name: "missing-equals", value: "k1=v1,k2", expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := New(Map{"MAP": tt.value}).GetMap("MAP")
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expectErr=%v, got=%v", tt.expectErr, err)
+			}
+			if fmt.Sprint(actual) != fmt.Sprint(tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestGetMapAsIn(t *testing.T) {
+	e := New(Map{"WEIGHTS": "a=1,b=2", "BAD": "a=x"})
+	actual, err := GetMapAsIn(e, "WEIGHTS", parseString, strconv.Atoi)
+	if err != nil || fmt.Sprint(actual) != "map[a:1 b:2]" {
+		t.Errorf("expected=map[a:1 b:2], got=%v (err=%v)", actual, err)
+	}
+	_, err = GetMapAsIn(e, "BAD", parseString, strconv.Atoi)
+	testCheckErr(t, err, &ParseError{})
+}
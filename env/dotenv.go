@@ -0,0 +1,119 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ReadDotEnv reads variables from a .env file, returning them as a Map which can be used as a Source.
+// See ParseDotEnv for the supported syntax.
+func ReadDotEnv(path string) (Map, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	m, err := ParseDotEnv(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return m, nil
+}
+
+// ParseDotEnv parses variables in the .env format from r.
+// Each line has the form KEY=VALUE, optionally preceded by "export ".
+// Blank lines and lines starting with '#' are ignored, as is a '#' comment following a value, if it is preceded by whitespace.
+// Values may be enclosed in single quotes, which are removed, or in double quotes, which are removed after
+// interpreting Go escape sequences such as \n. A '#' within quotes is part of the value.
+func ParseDotEnv(r io.Reader) (Map, error) {
+	m := make(Map)
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("line %d: expected KEY=VALUE", n)
+		}
+		value, err := parseDotEnvValue(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+		m[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// parseDotEnvValue parses the value s of a .env line, whose surrounding whitespace was removed.
+func parseDotEnvValue(s string) (string, error) {
+	switch {
+	case strings.HasPrefix(s, "'"):
+		end := strings.IndexByte(s[1:], '\'') + 1
+		if end == 0 {
+			return "", errors.New("unterminated single-quoted value")
+		}
+		return s[1:end], checkDotEnvComment(s[end+1:])
+	case strings.HasPrefix(s, `"`):
+		for i := 1; i < len(s); i++ {
+			switch s[i] {
+			case '\\':
+				i++ // skip the escaped character, which may be a quote.
+			case '"':
+				value, err := strconv.Unquote(s[:i+1])
+				if err != nil {
+					return "", err
+				}
+				return value, checkDotEnvComment(s[i+1:])
+			}
+		}
+		return "", errors.New("unterminated double-quoted value")
+	}
+	for i := range len(s) {
+		if s[i] == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t') {
+			return strings.TrimSpace(s[:i]), nil
+		}
+	}
+	return s, nil
+}
+
+// checkDotEnvComment checks that rest, which follows a quoted value, is empty or a comment.
+func checkDotEnvComment(rest string) error {
+	rest = strings.TrimSpace(rest)
+	if rest != "" && !strings.HasPrefix(rest, "#") {
+		return fmt.Errorf("unexpected %q after quoted value", rest)
+	}
+	return nil
+}
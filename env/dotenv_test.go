@@ -0,0 +1,88 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseDotEnv(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expect    Map
+		expectErr bool
+	}{
+		{name: "empty", input: "", expect: Map{}},
+		{
+			name:   "values",
+			input:  "# comment\n\nA=1\nexport B = two \nC='single # quoted'\nD=\"line\\nbreak\"\nE=\n",
+			expect: Map{"A": "1", "B": "two", "C": "single # quoted", "D": "line\nbreak", "E": ""},
+		},
+		{name: "comment-line", input: "  # A=1\nB=2\n", expect: Map{"B": "2"}},
+		{name: "inline-comment", input: "A=1 # the first\nB=2\t# the second\n", expect: Map{"A": "1", "B": "2"}},
+		{name: "inline-comment-only", input: "A= # unset\n", expect: Map{"A": ""}},
+		{name: "hash-in-value", input: "A=a#b\nB=#c\n", expect: Map{"A": "a#b", "B": ""}},
+		{name: "single-quoted", input: "A='  spaced \\n '\n", expect: Map{"A": "  spaced \\n "}},
+		{name: "single-quoted-comment", input: "A='x' # comment\n", expect: Map{"A": "x"}},
+		{name: "double-quoted", input: `A="say \"hi\"\t"`, expect: Map{"A": "say \"hi\"\t"}},
+		{name: "double-quoted-comment", input: `A="x # y" # comment`, expect: Map{"A": "x # y"}},
+		{name: "export", input: "export A=1\nexport B='2'\n", expect: Map{"A": "1", "B": "2"}},
+		{name: "equals-in-value", input: "A=b=c\n", expect: Map{"A": "b=c"}},
+		{name: "missing-equals", input: "A=1\nB\n", expectErr: true},
+		{name: "missing-key", input: "=1\n", expectErr: true},
+		{name: "export-only", input: "export \n", expectErr: true},
+		{name: "bad-escape", input: `A="\q"`, expectErr: true},
+		{name: "unterminated-single", input: "A='x\n", expectErr: true},
+		{name: "unterminated-double", input: `A="x\"`, expectErr: true},
+		{name: "after-quotes", input: "A='x' y\n", expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := ParseDotEnv(strings.NewReader(tt.input))
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expectErr=%v, got=%v", tt.expectErr, err)
+			}
+			if fmt.Sprintf("%q", actual) != fmt.Sprintf("%q", tt.expect) && !tt.expectErr {
+				t.Errorf("expected=%q, got=%q", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestReadDotEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	if err := os.WriteFile(path, []byte("A=1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	m, err := ReadDotEnv(path)
+	if err != nil || m["A"] != "1" {
+		t.Errorf("expected A=1, got=%v (err=%v)", m, err)
+	}
+	if _, err := ReadDotEnv(filepath.Join(t.TempDir(), "missing")); !os.IsNotExist(err) {
+		t.Errorf("expected not exist error, got=%v", err)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
+
+// GetJSON decodes the value of an environment variable as JSON into target, which must be a pointer.
+// Unknown object fields and trailing data are rejected, so that mistakes in the configuration are reported.
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it is not valid JSON for target.
+func (e *Env) GetJSON(key string, target any) error {
+	_, err := getAs(e, key, func(s string) (struct{}, error) {
+		dec := json.NewDecoder(strings.NewReader(s))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(target); err != nil {
+			return struct{}{}, err
+		}
+		if err := dec.Decode(&struct{}{}); !errors.Is(err, io.EOF) {
+			return struct{}{}, errTrailingData
+		}
+		return struct{}{}, nil
+	})
+	return err
+}
+
+// GetBase64 returns the value of an environment variable decoded as standard base64.
+// Padding is optional, and the URL-safe alphabet is also accepted.
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it is not valid base64.
+func (e *Env) GetBase64(key string) ([]byte, error) {
+	return getAs(e, key, decodeBase64)
+}
+
+// GetJSON is like Env.GetJSON, reading the process environment.
+func GetJSON(key string, target any) error {
+	return std.GetJSON(key, target)
+}
+
+// GetBase64 is like Env.GetBase64, reading the process environment.
+func GetBase64(key string) ([]byte, error) {
+	return std.GetBase64(key)
+}
+
+var errTrailingData = errors.New("unexpected data after JSON value")
+
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(strings.TrimSpace(s), "=")
+	if strings.ContainsAny(s, "-_") {
+		return base64.RawURLEncoding.DecodeString(s)
+	}
+	return base64.RawStdEncoding.DecodeString(s)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import "testing"
+
+func TestGetJSON(t *testing.T) {
+	type config struct {
+		Name  string   `json:"name"`
+		Hosts []string `json:"hosts"`
+	}
+	tests := []struct {
+		name      string
+		value     string
+		expect    config
+		expectErr error
+	}{
+		{name: "unset", value: "", expectErr: ErrNotSet},
+		{name: "valid", value: `{"name":"a","hosts":["h1","h2"]}`, expect: config{Name: "a", Hosts: []string{"h1", "h2"}}},
+		{name: "unknown-field", value: `{"nmae":"a"}`, expectErr: &ParseError{}},
+		{name: "trailing", value: `{"name":"a"} {}`, expectErr: &ParseError{}},
+		{name: "trailing-delimiter", value: `{"name":"a"}}`, expectErr: &ParseError{}},
+		{name: "trailing-space", value: "{\"name\":\"a\"} \n", expect: config{Name: "a"}},
+		{name: "invalid", value: `{"name":`, expectErr: &ParseError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual config
+			err := New(Map{"CONFIG": tt.value}).GetJSON("CONFIG", &actual)
+			testCheckErr(t, err, tt.expectErr)
+			if err == nil && (actual.Name != tt.expect.Name || len(actual.Hosts) != len(tt.expect.Hosts)) {
+				t.Errorf("expected=%+v, got=%+v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestGetBase64(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		expect    string
+		expectErr error
+	}{
+		{name: "unset", value: "", expectErr: ErrNotSet},
+		{name: "padded", value: "aGk/Pz8=", expect: "hi???"},
+		{name: "unpadded", value: "aGk/Pz8", expect: "hi???"},
+		{name: "url-safe", value: "aGk_Pz8", expect: "hi???"},
+		{name: "invalid", value: "!!!", expectErr: &ParseError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := New(Map{"BLOB": tt.value}).GetBase64("BLOB")
+			testCheckErr(t, err, tt.expectErr)
+			if string(actual) != tt.expect {
+				t.Errorf("expected=%q, got=%q", tt.expect, actual)
+			}
+		})
+	}
+}
@@ -21,13 +21,54 @@
 
 package env
 
-import "os"
+// Env reads environment variables from a Source.
+// The package-level functions use an Env that reads the environment of the current process;
+// an Env with a different Source allows testing code without mutating the real environment.
+//
+// A nil *Env, or an Env with a nil Source, reads the environment of the current process.
+type Env struct {
+	// Source is the source of the environment variables.
+	Source Source
+}
+
+// New creates an Env which reads variables from src.
+func New(src Source) *Env {
+	return &Env{Source: src}
+}
+
+var std = &Env{}
+
+func (e *Env) source() Source {
+	if e == nil || e.Source == nil {
+		return osSource{}
+	}
+	return e.Source
+}
 
 // GetWithDefault returns the value of an environment variable,
 // or the provided default if the environment was not set.
-func GetWithDefault(key string, def string) string {
-	if v := os.Getenv(key); v != "" {
+// If the variable is not set, its value is read from the file named by key+FileSuffix, if that is set.
+func (e *Env) GetWithDefault(key string, def string) string {
+	if v, _, err := e.lookupEnv(key); err == nil && v != "" {
 		return v
 	}
 	return def
 }
+
+// Get returns the value of an environment variable.
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it does not satisfy the rules.
+func (e *Env) Get(key string, rules ...Rule[string]) (string, error) {
+	return getAs(e, key, parseString, rules...)
+}
+
+// Get is like Env.Get, reading the process environment.
+func Get(key string, rules ...Rule[string]) (string, error) {
+	return std.Get(key, rules...)
+}
+
+// GetWithDefault returns the value of an environment variable,
+// or the provided default if the environment was not set.
+// If the variable is not set, its value is read from the file named by key+FileSuffix, if that is set.
+func GetWithDefault(key string, def string) string {
+	return std.GetWithDefault(key, def)
+}
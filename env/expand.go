@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Expand replaces $VAR and ${VAR} in s with the values of the corresponding variables,
+// supporting the shell-style default and error syntax:
+//
+//   - ${VAR:-default} expands to default if VAR is not set or empty.
+//   - ${VAR-default} expands to default if VAR is not set.
+//   - ${VAR:?message} is an error if VAR is not set or empty.
+//   - ${VAR?message} is an error if VAR is not set.
+//
+// Undefined variables without a default expand to the empty string. Errors wrap ErrNotSet and include the message.
+// Defaults are used literally, and cannot themselves contain variable references.
+func (e *Env) Expand(s string) (string, error) {
+	var firstErr error
+	result := os.Expand(s, func(expr string) string {
+		v, err := e.expandVar(expr)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		return v
+	})
+	if firstErr != nil {
+		return "", firstErr
+	}
+	return result, nil
+}
+
+// Expand is like Env.Expand, reading the process environment.
+func Expand(s string) (string, error) {
+	return std.Expand(s)
+}
+
+func (e *Env) expandVar(expr string) (string, error) {
+	i := strings.IndexAny(expr, ":-?")
+	if i < 0 {
+		v, _, err := e.lookupEnv(expr)
+		return v, err
+	}
+	name, op := expr[:i], expr[i:]
+	checkEmpty := strings.HasPrefix(op, ":")
+	op = strings.TrimPrefix(op, ":")
+	if op == "" {
+		// not a recognized operator, such as a literal ':' in a name.
+		v, _, err := e.lookupEnv(expr)
+		return v, err
+	}
+	v, ok, err := e.lookupEnv(name)
+	if err != nil {
+		return "", err
+	}
+	missing := !ok || (checkEmpty && v == "")
+	switch op[0] {
+	case '-':
+		if missing {
+			return op[1:], nil
+		}
+	case '?':
+		if missing {
+			if msg := op[1:]; msg != "" {
+				return "", fmt.Errorf("%w: %s: %s", ErrNotSet, name, msg)
+			}
+			return "", errNotSet(name)
+		}
+	default:
+		v, _, err := e.lookupEnv(expr)
+		return v, err
+	}
+	return v, nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleEnv_Expand() {
+	e := New(Map{"HOST": "db.internal", "PORT": ""})
+	s, err := e.Expand("postgres://${HOST}:${PORT:-5432}/${DB:-app}")
+	fmt.Println(s, err)
+	_, err = e.Expand("${PASSWORD:?database password is required}")
+	fmt.Println(err)
+	// Output:
+	// postgres://db.internal:5432/app <nil>
+	// env: variable not set: PASSWORD: database password is required
+}
+
+func TestExpand(t *testing.T) {
+	e := New(Map{"SET": "value", "EMPTY": ""})
+	tests := []struct {
+		name      string
+		input     string
+		expect    string
+		expectErr bool
+	}{
+		{name: "plain", input: "$SET and ${SET}", expect: "value and value"},
+		{name: "undefined", input: "[${UNSET}]", expect: "[]"},
+		{name: "no-vars", input: "plain text", expect: "plain text"},
+		{name: "colon-default-set", input: "${SET:-def}", expect: "value"},
+		{name: "colon-default-empty", input: "${EMPTY:-def}", expect: "def"},
+		{name: "colon-default-unset", input: "${UNSET:-def}", expect: "def"},
+		{name: "default-empty", input: "[${EMPTY-def}]", expect: "[]"},
+		{name: "default-unset", input: "${UNSET-def}", expect: "def"},
+		{name: "default-empty-value", input: "[${UNSET:-}]", expect: "[]"},
+		{name: "colon-error-set", input: "${SET:?missing}", expect: "value"},
+		{name: "colon-error-empty", input: "${EMPTY:?missing}", expectErr: true},
+		{name: "error-empty", input: "[${EMPTY?missing}]", expect: "[]"},
+		{name: "error-unset", input: "${UNSET?}", expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := e.Expand(tt.input)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expectErr=%v, got=%v", tt.expectErr, err)
+			}
+			if err != nil && !errors.Is(err, ErrNotSet) {
+				t.Errorf("expected ErrNotSet, got=%v", err)
+			}
+			if actual != tt.expect {
+				t.Errorf("expected=%q, got=%q", tt.expect, actual)
+			}
+		})
+	}
+}
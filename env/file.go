@@ -0,0 +1,116 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// FileSuffix is appended to the name of an environment variable to find the path of a file holding its value.
+//
+// When a variable such as DB_PASSWORD is not set, but DB_PASSWORD_FILE=/run/secrets/db_password is,
+// the getters in this package read the value from the file instead. This is the convention used
+// by Docker and Kubernetes to pass secrets to containers. The file is read using the default
+// FileOption values: at most DefaultMaxFileSize bytes, with trailing newlines removed.
+const FileSuffix = "_FILE"
+
+// DefaultMaxFileSize is the default maximum size of a file read through FileSuffix indirection.
+const DefaultMaxFileSize = 64 << 10
+
+// FileOption configures how ReadFile reads the value of a variable from a file.
+type FileOption func(o *fileOptions)
+
+// WithMaxFileSize sets the maximum number of bytes read from the file.
+// Files larger than this are an error rather than being truncated.
+func WithMaxFileSize(n int64) FileOption {
+	return func(o *fileOptions) {
+		o.maxSize = n
+	}
+}
+
+// WithoutTrim keeps the contents of the file as-is, instead of removing trailing newlines.
+func WithoutTrim() FileOption {
+	return func(o *fileOptions) {
+		o.trim = false
+	}
+}
+
+type fileOptions struct {
+	maxSize int64
+	trim    bool
+}
+
+// ReadFile returns the contents of the file named by the environment variable key+FileSuffix.
+// It returns an error wrapping ErrNotSet if that variable is not set.
+func (e *Env) ReadFile(key string, opts ...FileOption) (string, error) {
+	o := fileOptions{maxSize: DefaultMaxFileSize, trim: true}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	fileKey := key + FileSuffix
+	path, _ := e.source().Lookup(fileKey)
+	if path == "" {
+		return "", errNotSet(fileKey)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("env: %s: %w", fileKey, err)
+	}
+	defer f.Close()
+	data, err := io.ReadAll(io.LimitReader(f, o.maxSize+1))
+	if err != nil {
+		return "", fmt.Errorf("env: %s: %w", fileKey, err)
+	}
+	if int64(len(data)) > o.maxSize {
+		return "", fmt.Errorf("env: %s: file %s is larger than %d bytes", fileKey, path, o.maxSize)
+	}
+	value := string(data)
+	if o.trim {
+		value = strings.TrimRight(value, "\r\n")
+	}
+	return value, nil
+}
+
+// ReadFile is like Env.ReadFile, reading the process environment.
+func ReadFile(key string, opts ...FileOption) (string, error) {
+	return std.ReadFile(key, opts...)
+}
+
+// lookupEnv returns the value of the environment variable key, falling back to the file named by
+// key+FileSuffix when key is not set. The boolean reports whether either variable was set.
+func (e *Env) lookupEnv(key string) (string, bool, error) {
+	src := e.source()
+	if v, ok := src.Lookup(key); ok {
+		return v, true, nil
+	}
+	if path, _ := src.Lookup(key + FileSuffix); path == "" {
+		return "", false, nil
+	}
+	v, err := e.ReadFile(key)
+	if err != nil {
+		return "", true, err
+	}
+	return v, true, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func testWriteFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "secret")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+	return path
+}
+
+func TestReadFile(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		opts      []FileOption
+		expect    string
+		expectErr bool
+	}{
+		{name: "trim", content: "s3cret\n", expect: "s3cret"},
+		{name: "trim-crlf", content: "s3cret\r\n", expect: "s3cret"},
+		{name: "no-trim", content: "s3cret\n", opts: []FileOption{WithoutTrim()}, expect: "s3cret\n"},
+		{name: "max-size", content: "s3cret", opts: []FileOption{WithMaxFileSize(6)}, expect: "s3cret"},
+		{name: "too-large", content: "s3cret", opts: []FileOption{WithMaxFileSize(5)}, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_ENV_SECRET_FILE", testWriteFile(t, tt.content))
+			actual, err := ReadFile("TEST_ENV_SECRET", tt.opts...)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expectErr=%v, got=%v", tt.expectErr, err)
+			}
+			if actual != tt.expect {
+				t.Errorf("expected=%q, got=%q", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestReadFile_notSet(t *testing.T) {
+	_ = os.Unsetenv("TEST_ENV_SECRET_FILE")
+	if _, err := ReadFile("TEST_ENV_SECRET"); !errors.Is(err, ErrNotSet) {
+		t.Errorf("expected ErrNotSet, got=%v", err)
+	}
+	t.Setenv("TEST_ENV_SECRET_FILE", filepath.Join(t.TempDir(), "missing"))
+	if _, err := ReadFile("TEST_ENV_SECRET"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected os.ErrNotExist, got=%v", err)
+	}
+}
+
+func TestFileIndirection(t *testing.T) {
+	_ = os.Unsetenv("TEST_ENV_PORT")
+	t.Setenv("TEST_ENV_PORT_FILE", testWriteFile(t, "8080\n"))
+	if v := GetWithDefault("TEST_ENV_PORT", "80"); v != "8080" {
+		t.Errorf("GetWithDefault: expected=8080, got=%v", v)
+	}
+	if v, err := GetInt("TEST_ENV_PORT"); err != nil || v != 8080 {
+		t.Errorf("GetInt: expected=8080, got=%v (err=%v)", v, err)
+	}
+	lv := Lookup("TEST_ENV_PORT")
+	if v, ok := lv.Get(); !ok || v != "8080" {
+		t.Errorf("Lookup: expected=8080, got=%v", lv)
+	}
+	if err := Require("TEST_ENV_PORT"); err != nil {
+		t.Errorf("Require: expected no error, got=%v", err)
+	}
+	t.Setenv("TEST_ENV_PORT", "9090")
+	if v := GetWithDefault("TEST_ENV_PORT", "80"); v != "9090" {
+		t.Errorf("GetWithDefault: expected variable to take precedence, got=%v", v)
+	}
+}
+
+func TestFileIndirection_error(t *testing.T) {
+	_ = os.Unsetenv("TEST_ENV_PORT")
+	t.Setenv("TEST_ENV_PORT_FILE", testWriteFile(t, strings.Repeat("x", DefaultMaxFileSize+1)))
+	if v := GetWithDefault("TEST_ENV_PORT", "80"); v != "80" {
+		t.Errorf("GetWithDefault: expected=80, got=%v", v)
+	}
+	if _, err := GetInt("TEST_ENV_PORT"); err == nil || errors.Is(err, ErrNotSet) {
+		t.Errorf("GetInt: expected file error, got=%v", err)
+	}
+	if err := Require("TEST_ENV_PORT"); err == nil || errors.Is(err, ErrNotSet) {
+		t.Errorf("Require: expected file error, got=%v", err)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FeatureFlag is a boolean feature gate controlled by an environment variable.
+type FeatureFlag struct {
+	name string
+	def  bool
+	env  *Env
+}
+
+// Flag creates a FeatureFlag controlled by the variable name. It accepts the same options as Var:
+// Default sets whether the feature is enabled when the variable is not set, and From sets the Env it is read from.
+//
+//	var newCheckout = env.Flag("FEATURE_NEW_CHECKOUT", env.Default(false))
+//
+//	if newCheckout.Enabled() {
+//		...
+//	}
+//
+// Flag panics if a Default is given that is not a bool.
+func Flag(name string, opts ...Option) *FeatureFlag {
+	var o varOptions
+	for _, opt := range opts {
+		opt.apply(&o)
+	}
+	f := &FeatureFlag{name: name, env: o.env}
+	if o.def != nil {
+		def, ok := o.def.(bool)
+		if !ok {
+			panic(fmt.Sprintf("env: default for flag %s has type %T, expected bool", name, o.def))
+		}
+		f.def = def
+	}
+	return f
+}
+
+// Name returns the name of the variable controlling the flag.
+func (f *FeatureFlag) Name() string {
+	return f.name
+}
+
+// Enabled reports whether the feature is enabled.
+// The values "1", "t", "true", "y", "yes", "on" and "enabled" enable the feature, and "0", "f", "false", "n", "no", "off"
+// and "disabled" disable it, ignoring case and surrounding whitespace. If the variable is not set, is empty,
+// or has any other value, Enabled returns the flag's default.
+func (f *FeatureFlag) Enabled() bool {
+	v := strings.ToLower(strings.TrimSpace(f.env.GetWithDefault(f.name, "")))
+	switch v {
+	case "1", "t", "true", "y", "yes", "on", "enabled":
+		return true
+	case "0", "f", "false", "n", "no", "off", "disabled":
+		return false
+	}
+	return f.def
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"testing"
+
+	"github.com/justenwalker/got/fault"
+)
+
+func TestFlag(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		def    bool
+		expect bool
+	}{
+		{name: "unset", value: "", def: false, expect: false},
+		{name: "unset-default", value: "", def: true, expect: true},
+		{name: "true", value: "true", def: false, expect: true},
+		{name: "yes-upper", value: " YES ", def: false, expect: true},
+		{name: "one", value: "1", def: false, expect: true},
+		{name: "enabled", value: "Enabled", def: false, expect: true},
+		{name: "off", value: "off", def: true, expect: false},
+		{name: "zero", value: "0", def: true, expect: false},
+		{name: "unknown", value: "maybe", def: true, expect: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := Flag("FEATURE_X", Default(tt.def), From(New(Map{"FEATURE_X": tt.value})))
+			if actual := f.Enabled(); actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestFlag_processEnv(t *testing.T) {
+	t.Setenv("TEST_ENV_FEATURE", "on")
+	if f := Flag("TEST_ENV_FEATURE"); !f.Enabled() || f.Name() != "TEST_ENV_FEATURE" {
+		t.Errorf("expected flag to be enabled")
+	}
+}
+
+func TestFlag_badDefault(t *testing.T) {
+	err := fault.Catch(func() error {
+		Flag("FEATURE_X", Default("yes"))
+		return nil
+	})
+	if err == nil {
+		t.Errorf("expected panic")
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/justenwalker/got/fault"
+)
+
+// FlagKey returns the name of the environment variable that BindFlags uses for the flag name:
+// prefix followed by the flag name in upper case, with '-' and '.' replaced by '_'.
+// For example, FlagKey("APP_", "log-level") is "APP_LOG_LEVEL".
+func FlagKey(prefix string, name string) string {
+	return prefix + strings.ToUpper(strings.NewReplacer("-", "_", ".", "_").Replace(name))
+}
+
+// BindFlags sets each flag defined in fs from its corresponding environment variable, named by FlagKey.
+// It must be called after the flags are defined and before fs.Parse, so that flags given on the
+// command line take precedence over the environment, which takes precedence over the flag defaults:
+//
+//	fs := flag.NewFlagSet("app", flag.ExitOnError)
+//	addr := fs.String("listen-addr", ":8080", "listen address")
+//	if err := env.BindFlags(fs, "APP_"); err != nil { // reads APP_LISTEN_ADDR
+//		log.Fatal(err)
+//	}
+//	fs.Parse(os.Args[1:])
+//
+// Variables that are not set or empty are ignored. BindFlags returns a single error describing every
+// variable whose value was rejected by its flag.
+func (e *Env) BindFlags(fs *flag.FlagSet, prefix string) error {
+	var errs fault.List
+	fs.VisitAll(func(f *flag.Flag) {
+		key := FlagKey(prefix, f.Name)
+		v, _, err := e.lookupEnv(key)
+		if err != nil {
+			errs.Append(err)
+			return
+		}
+		if v == "" {
+			return
+		}
+		if err := fs.Set(f.Name, v); err != nil {
+			errs.Append(&ParseError{Key: key, Value: v, Err: fmt.Errorf("flag -%s: %w", f.Name, err)})
+		}
+	})
+	return errs.Err()
+}
+
+// BindFlags is like Env.BindFlags, reading the process environment.
+func BindFlags(fs *flag.FlagSet, prefix string) error {
+	return std.BindFlags(fs, prefix)
+}
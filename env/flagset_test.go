@@ -0,0 +1,76 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFlagKey(t *testing.T) {
+	if actual := FlagKey("APP_", "log-level.json"); actual != "APP_LOG_LEVEL_JSON" {
+		t.Errorf("expected=APP_LOG_LEVEL_JSON, got=%v", actual)
+	}
+}
+
+func TestBindFlags(t *testing.T) {
+	e := New(Map{"APP_ADDR": ":9090", "APP_VERBOSE": "true", "APP_TIMEOUT": "5s", "APP_NAME": ""})
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	addr := fs.String("addr", ":8080", "")
+	verbose := fs.Bool("verbose", false, "")
+	timeout := fs.Duration("timeout", time.Second, "")
+	name := fs.String("name", "default", "")
+	if err := e.BindFlags(fs, "APP_"); err != nil {
+		t.Fatalf("BindFlags: %v", err)
+	}
+	if err := fs.Parse([]string{"-timeout", "10s"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if *addr != ":9090" {
+		t.Errorf("addr: expected=:9090, got=%v", *addr)
+	}
+	if !*verbose {
+		t.Errorf("verbose: expected=true, got=%v", *verbose)
+	}
+	if *timeout != 10*time.Second {
+		t.Errorf("timeout: expected command line to win, got=%v", *timeout)
+	}
+	if *name != "default" {
+		t.Errorf("name: expected=default, got=%v", *name)
+	}
+}
+
+func TestBindFlags_errors(t *testing.T) {
+	e := New(Map{"PORT": "abc", "DEBUG": "maybe"})
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	fs.Int("port", 80, "")
+	fs.Bool("debug", false, "")
+	err := e.BindFlags(fs, "")
+	if err == nil || !strings.HasPrefix(err.Error(), "2 errors occurred:") {
+		t.Fatalf("expected 2 errors, got=%v", err)
+	}
+	testCheckErr(t, err, &ParseError{})
+}
@@ -0,0 +1,60 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import "github.com/justenwalker/got/optional"
+
+// Lookup returns the value of an environment variable, or Nothing if it is not set.
+// Unlike GetWithDefault, a variable that is set to the empty string is a valid value.
+func (e *Env) Lookup(key string) optional.Value[string] {
+	if v, ok, err := e.lookupEnv(key); ok && err == nil {
+		return optional.New(v)
+	}
+	return optional.Nothing[string]()
+}
+
+// Lookup is like Env.Lookup, reading the process environment.
+func Lookup(key string) optional.Value[string] {
+	return std.Lookup(key)
+}
+
+// LookupAs returns the value of a variable in the process environment converted with parse,
+// or Nothing if it is not set. If the variable is set but parse fails, LookupAs returns a *ParseError.
+func LookupAs[T any](key string, parse func(string) (T, error)) (optional.Value[T], error) {
+	return LookupAsIn(std, key, parse)
+}
+
+// LookupAsIn is like LookupAs, reading the variable from e.
+func LookupAsIn[T any](e *Env, key string, parse func(string) (T, error)) (optional.Value[T], error) {
+	s, ok, err := e.lookupEnv(key)
+	if err != nil {
+		return optional.Nothing[T](), err
+	}
+	if !ok {
+		return optional.Nothing[T](), nil
+	}
+	v, err := parse(s)
+	if err != nil {
+		return optional.Nothing[T](), &ParseError{Key: key, Value: s, Err: err}
+	}
+	return optional.New(v), nil
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	_ = os.Unsetenv("TEST_ENV_LOOKUP")
+	if v := Lookup("TEST_ENV_LOOKUP"); v.IsValid() {
+		t.Errorf("expected Nothing, got=%v", v)
+	}
+	t.Setenv("TEST_ENV_LOOKUP", "")
+	v := Lookup("TEST_ENV_LOOKUP")
+	if s, ok := v.Get(); !ok || s != "" {
+		t.Errorf("expected empty valid value, got=%v", v)
+	}
+}
+
+func TestLookupAs(t *testing.T) {
+	_ = os.Unsetenv("TEST_ENV_LOOKUP_AS")
+	v, err := LookupAs("TEST_ENV_LOOKUP_AS", strconv.Atoi)
+	if err != nil || v.IsValid() {
+		t.Errorf("expected Nothing, got=%v (err=%v)", v, err)
+	}
+	t.Setenv("TEST_ENV_LOOKUP_AS", "12")
+	v, err = LookupAs("TEST_ENV_LOOKUP_AS", strconv.Atoi)
+	if n, ok := v.Get(); err != nil || !ok || n != 12 {
+		t.Errorf("expected=12, got=%v (err=%v)", v, err)
+	}
+	t.Setenv("TEST_ENV_LOOKUP_AS", "x")
+	v, err = LookupAs("TEST_ENV_LOOKUP_AS", strconv.Atoi)
+	testCheckErr(t, err, &ParseError{})
+	if v.IsValid() {
+		t.Errorf("expected Nothing, got=%v", v)
+	}
+}
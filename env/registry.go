@@ -0,0 +1,333 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/justenwalker/got/fault"
+)
+
+// Registry records the environment variables declared by a program with Var,
+// so that they can be documented with Usage or Markdown and validated up front with Check.
+type Registry struct {
+	env  *Env
+	mu   sync.Mutex
+	vars []registeredVar
+}
+
+type registeredVar struct {
+	info  VarInfo
+	check func() error
+}
+
+// NewRegistry creates a Registry whose variables are read from e. If e is nil, they are read from the process environment.
+func NewRegistry(e *Env) *Registry {
+	return &Registry{env: e}
+}
+
+var defaultRegistry = NewRegistry(nil)
+
+// VarInfo describes a declared variable.
+type VarInfo struct {
+	// Name is the name of the environment variable.
+	Name string
+	// Type is the Go type of the variable's value.
+	Type string
+	// Description is the description given with Desc.
+	Description string
+	// Default is the default value given with Default, formatted with fmt.Sprint.
+	// It is empty if the variable has no default.
+	Default string
+	// Required indicates that the variable must be set.
+	Required bool
+}
+
+// Option configures a variable declared with Var.
+type Option interface {
+	apply(o *varOptions)
+}
+
+type varOptions struct {
+	desc     string
+	def      any
+	parse    any
+	required bool
+	env      *Env
+	rules    []any
+}
+
+type optionFunc func(o *varOptions)
+
+func (f optionFunc) apply(o *varOptions) {
+	f(o)
+}
+
+// Desc sets the description of a variable.
+func Desc(description string) Option {
+	return optionFunc(func(o *varOptions) {
+		o.desc = description
+	})
+}
+
+// Default sets the value of a variable when it is not set.
+// The type of def must match the type of the variable exactly, so an untyped constant may need a conversion:
+//
+//	env.Var[int64]("MAX_BYTES", env.Default(int64(1024)))
+func Default[T any](def T) Option {
+	return optionFunc(func(o *varOptions) {
+		o.def = def
+	})
+}
+
+// Required makes it an error for the variable not to be set.
+func Required() Option {
+	return optionFunc(func(o *varOptions) {
+		o.required = true
+	})
+}
+
+// From reads the variable from e, instead of the Env of the Registry.
+func From(e *Env) Option {
+	return optionFunc(func(o *varOptions) {
+		o.env = e
+	})
+}
+
+// Parse sets the function used to convert the variable's value.
+// It is required for types that are not supported by default; the supported types are
+// string, int, int64, uint, uint64, float64, bool, time.Duration, *url.URL, netip.Addr, and netip.Prefix.
+func Parse[T any](parse func(string) (T, error)) Option {
+	return optionFunc(func(o *varOptions) {
+		o.parse = parse
+	})
+}
+
+// Variable is an environment variable declared with Var.
+type Variable[T any] struct {
+	env    *Env
+	info   VarInfo
+	def    T
+	hasDef bool
+	parse  func(string) (T, error)
+	rules  []Rule[T]
+}
+
+// Var declares an environment variable with the default registry and returns it.
+// Declarations are typically package-level variables, so that the program's configuration is documented in one place:
+//
+//	var port = env.Var[int]("PORT", env.Default(8080), env.Desc("listen port"))
+//
+// Var panics if the options, such as Default or a Rule, do not match the type T, or if T has no default parser and no Parse option is given.
+func Var[T any](name string, opts ...Option) *Variable[T] {
+	return VarIn[T](defaultRegistry, name, opts...)
+}
+
+// VarIn is like Var, declaring the variable with r.
+func VarIn[T any](r *Registry, name string, opts ...Option) *Variable[T] {
+	var o varOptions
+	for _, opt := range opts {
+		opt.apply(&o)
+	}
+	v := &Variable[T]{
+		env: r.env,
+		info: VarInfo{
+			Name:        name,
+			Type:        reflect.TypeFor[T]().String(),
+			Description: o.desc,
+			Required:    o.required,
+		},
+	}
+	if o.env != nil {
+		v.env = o.env
+	}
+	if o.def != nil {
+		def, ok := o.def.(T)
+		if !ok {
+			panic(fmt.Sprintf("env: default for %s has type %T, expected %s", name, o.def, v.info.Type))
+		}
+		v.def, v.hasDef = def, true
+		v.info.Default = fmt.Sprint(def)
+	}
+	if o.parse != nil {
+		parse, ok := o.parse.(func(string) (T, error))
+		if !ok {
+			panic(fmt.Sprintf("env: parser for %s has type %T, expected func(string) (%s, error)", name, o.parse, v.info.Type))
+		}
+		v.parse = parse
+	} else if v.parse = defaultParser[T](); v.parse == nil {
+		panic(fmt.Sprintf("env: no parser for %s of type %s", name, v.info.Type))
+	}
+	for _, rule := range o.rules {
+		typed, ok := rule.(Rule[T])
+		if !ok {
+			panic(fmt.Sprintf("env: rule for %s has type %T, expected env.Rule[%s]", name, rule, v.info.Type))
+		}
+		v.rules = append(v.rules, typed)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.vars = append(r.vars, registeredVar{info: v.info, check: func() error {
+		_, err := v.Get()
+		return err
+	}})
+	return v
+}
+
+// Info returns the description of the variable.
+func (v *Variable[T]) Info() VarInfo {
+	return v.info
+}
+
+// Get returns the value of the variable.
+// If it is not set, Get returns its default, or an error wrapping ErrNotSet if it is Required.
+// A variable with no default that is not required is the zero value of T when it is not set.
+// If the value cannot be parsed or does not satisfy the variable's rules, Get returns a *ParseError.
+func (v *Variable[T]) Get() (T, error) {
+	val, err := getAs(v.env, v.info.Name, v.parse, v.rules...)
+	if err == nil || !errors.Is(err, ErrNotSet) {
+		return val, err
+	}
+	switch {
+	case v.hasDef:
+		return v.def, nil
+	case v.info.Required:
+		return val, err
+	}
+	return val, nil
+}
+
+// Vars returns the variables declared with r, in the order they were declared.
+func (r *Registry) Vars() []VarInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	infos := make([]VarInfo, len(r.vars))
+	for i, v := range r.vars {
+		infos[i] = v.info
+	}
+	return infos
+}
+
+// Check gets the value of every variable declared with r, and returns a single error
+// describing all variables that are missing or invalid.
+func (r *Registry) Check() error {
+	r.mu.Lock()
+	vars := append([]registeredVar(nil), r.vars...)
+	r.mu.Unlock()
+	var errs fault.List
+	for _, v := range vars {
+		errs.Append(v.check())
+	}
+	return errs.Err()
+}
+
+// Usage writes a table describing the variables declared with r to w, suitable for a --help-env flag.
+func (r *Registry) Usage(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "NAME\tTYPE\tDEFAULT\tDESCRIPTION")
+	for _, v := range r.Vars() {
+		def := v.Default
+		if v.Required {
+			def = "(required)"
+		}
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", v.Name, v.Type, def, v.Description)
+	}
+	return tw.Flush()
+}
+
+// Markdown writes a Markdown table describing the variables declared with r to w, suitable for a README.
+func (r *Registry) Markdown(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("| Name | Type | Default | Description |\n")
+	sb.WriteString("|------|------|---------|-------------|\n")
+	for _, v := range r.Vars() {
+		def := "`" + v.Default + "`"
+		switch {
+		case v.Required:
+			def = "*required*"
+		case v.Default == "":
+			def = ""
+		}
+		fmt.Fprintf(&sb, "| `%s` | %s | %s | %s |\n", v.Name, v.Type, def, strings.ReplaceAll(v.Description, "|", `\|`))
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// Vars returns the variables declared with Var. See Registry.Vars.
+func Vars() []VarInfo {
+	return defaultRegistry.Vars()
+}
+
+// Check validates the variables declared with Var. See Registry.Check.
+func Check() error {
+	return defaultRegistry.Check()
+}
+
+// Usage writes a table describing the variables declared with Var to w. See Registry.Usage.
+func Usage(w io.Writer) error {
+	return defaultRegistry.Usage(w)
+}
+
+func defaultParser[T any]() func(string) (T, error) {
+	var parse any
+	switch any(*new(T)).(type) {
+	case string:
+		parse = parseString
+	case int:
+		parse = strconv.Atoi
+	case int64:
+		parse = func(s string) (int64, error) { return strconv.ParseInt(s, 10, 64) }
+	case uint:
+		parse = func(s string) (uint, error) {
+			n, err := strconv.ParseUint(s, 10, 0)
+			return uint(n), err
+		}
+	case uint64:
+		parse = func(s string) (uint64, error) { return strconv.ParseUint(s, 10, 64) }
+	case float64:
+		parse = parseFloat64
+	case bool:
+		parse = strconv.ParseBool
+	case time.Duration:
+		parse = time.ParseDuration
+	case *url.URL:
+		parse = parseURL
+	case netip.Addr:
+		parse = netip.ParseAddr
+	case netip.Prefix:
+		parse = netip.ParsePrefix
+	default:
+		return nil
+	}
+	return parse.(func(string) (T, error))
+}
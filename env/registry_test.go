@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/fault"
+)
+
+func ExampleRegistry_Usage() {
+	r := NewRegistry(New(Map{}))
+	VarIn[int](r, "PORT", Default(8080), Desc("listen port"))
+	VarIn[string](r, "DATABASE_URL", Required(), Desc("database connection string"))
+	VarIn[time.Duration](r, "TIMEOUT", Default(5*time.Second))
+	_ = r.Usage(os.Stdout)
+	// Output:
+	// NAME          TYPE           DEFAULT     DESCRIPTION
+	// PORT          int            8080        listen port
+	// DATABASE_URL  string         (required)  database connection string
+	// TIMEOUT       time.Duration  5s
+}
+
+func TestVariable_Get(t *testing.T) {
+	r := NewRegistry(New(Map{"SET": "42", "BAD": "x"}))
+	tests := []struct {
+		name      string
+		variable  *Variable[int]
+		expect    int
+		expectErr error
+	}{
+		{name: "set", variable: VarIn[int](r, "SET", Default(1)), expect: 42},
+		{name: "default", variable: VarIn[int](r, "UNSET", Default(1)), expect: 1},
+		{name: "zero", variable: VarIn[int](r, "UNSET"), expect: 0},
+		{name: "required", variable: VarIn[int](r, "UNSET", Required()), expectErr: ErrNotSet},
+		{name: "invalid", variable: VarIn[int](r, "BAD", Default(1)), expectErr: &ParseError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := tt.variable.Get()
+			testCheckErr(t, err, tt.expectErr)
+			if actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestVarIn_parse(t *testing.T) {
+	type level int
+	r := NewRegistry(New(Map{"LEVEL": "debug"}))
+	v := VarIn[level](r, "LEVEL", Parse(func(s string) (level, error) {
+		if s == "debug" {
+			return 1, nil
+		}
+		return 0, errors.New("unknown level")
+	}))
+	if actual, err := v.Get(); err != nil || actual != 1 {
+		t.Errorf("expected=1, got=%v (err=%v)", actual, err)
+	}
+}
+
+func TestVarIn_panics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func(r *Registry)
+	}{
+		{name: "default-type", fn: func(r *Registry) { VarIn[int64](r, "X", Default(1)) }},
+		{name: "parse-type", fn: func(r *Registry) { VarIn[int](r, "X", Parse(strconv64)) }},
+		{name: "no-parser", fn: func(r *Registry) { VarIn[struct{}](r, "X") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fault.Catch(func() error {
+				tt.fn(NewRegistry(nil))
+				return nil
+			})
+			if err == nil {
+				t.Errorf("expected panic")
+			}
+		})
+	}
+}
+
+func strconv64(string) (int64, error) {
+	return 0, nil
+}
+
+func TestRegistry_Check(t *testing.T) {
+	r := NewRegistry(New(Map{"BAD": "x", "OK": "1"}))
+	VarIn[int](r, "OK")
+	VarIn[int](r, "BAD")
+	VarIn[string](r, "MISSING", Required())
+	err := r.Check()
+	var pe *ParseError
+	if !errors.As(err, &pe) || !errors.Is(err, ErrNotSet) {
+		t.Fatalf("expected ParseError and ErrNotSet, got=%v", err)
+	}
+	if !strings.HasPrefix(err.Error(), "2 errors occurred:") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
+
+func TestRegistry_Markdown(t *testing.T) {
+	r := NewRegistry(nil)
+	VarIn[int](r, "PORT", Default(8080), Desc("listen port"))
+	VarIn[string](r, "TOKEN", Required(), Desc("api token | secret"))
+	VarIn[bool](r, "DEBUG")
+	var sb strings.Builder
+	if err := r.Markdown(&sb); err != nil {
+		t.Fatal(err)
+	}
+	expect := "| Name | Type | Default | Description |\n" +
+		"|------|------|---------|-------------|\n" +
+		"| `PORT` | int | `8080` | listen port |\n" +
+		"| `TOKEN` | string | *required* | api token \\| secret |\n" +
+		"| `DEBUG` | bool |  |  |\n"
+	if sb.String() != expect {
+		t.Errorf("expected=\n%s\ngot=\n%s", expect, sb.String())
+	}
+}
+
+func TestVarIn_from(t *testing.T) {
+	r := NewRegistry(New(Map{"A": "registry"}))
+	v := VarIn[string](r, "A", From(New(Map{"A": "override"})))
+	if actual, err := v.Get(); err != nil || actual != "override" {
+		t.Errorf("expected=override, got=%v (err=%v)", actual, err)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import "github.com/justenwalker/got/fault"
+
+// Require checks that all the given environment variables are set to a non-empty value.
+// It returns a single error naming every missing variable, so that a program can report
+// all of its missing configuration at once when it starts. Each missing variable is reported
+// as an error wrapping ErrNotSet.
+func (e *Env) Require(keys ...string) error {
+	var errs fault.List
+	for _, key := range keys {
+		v, _, err := e.lookupEnv(key)
+		switch {
+		case err != nil:
+			errs.Append(err)
+		case v == "":
+			errs.Append(errNotSet(key))
+		}
+	}
+	return errs.Err()
+}
+
+// MustGet returns the value of an environment variable, and panics if it is not set to a non-empty value.
+// It is intended for initialization code, where a missing variable cannot be handled.
+func (e *Env) MustGet(key string) string {
+	v, _, err := e.lookupEnv(key)
+	if err != nil {
+		panic(err)
+	}
+	if v == "" {
+		panic(errNotSet(key))
+	}
+	return v
+}
+
+// Require is like Env.Require, reading the process environment.
+func Require(keys ...string) error {
+	return std.Require(keys...)
+}
+
+// MustGet is like Env.MustGet, reading the process environment.
+func MustGet(key string) string {
+	return std.MustGet(key)
+}
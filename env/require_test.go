@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/justenwalker/got/fault"
+)
+
+func TestRequire(t *testing.T) {
+	t.Setenv("TEST_ENV_REQUIRE_A", "a")
+	t.Setenv("TEST_ENV_REQUIRE_B", "")
+	if err := Require("TEST_ENV_REQUIRE_A"); err != nil {
+		t.Errorf("expected no error, got=%v", err)
+	}
+	err := Require("TEST_ENV_REQUIRE_A", "TEST_ENV_REQUIRE_B", "TEST_ENV_REQUIRE_C")
+	if !errors.Is(err, ErrNotSet) {
+		t.Fatalf("expected ErrNotSet, got=%v", err)
+	}
+	expect := "2 errors occurred:\n" +
+		"\t* env: variable not set: TEST_ENV_REQUIRE_B\n" +
+		"\t* env: variable not set: TEST_ENV_REQUIRE_C"
+	if err.Error() != expect {
+		t.Errorf("expected=%q, got=%q", expect, err.Error())
+	}
+}
+
+func TestMustGet(t *testing.T) {
+	t.Setenv("TEST_ENV_MUST", "value")
+	if v := MustGet("TEST_ENV_MUST"); v != "value" {
+		t.Errorf("expected=value, got=%v", v)
+	}
+	t.Setenv("TEST_ENV_MUST", "")
+	err := fault.Catch(func() error {
+		MustGet("TEST_ENV_MUST")
+		return nil
+	})
+	if !errors.Is(err, ErrNotSet) {
+		t.Errorf("expected ErrNotSet, got=%v", err)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+// Layer is a named Source used by a Resolver.
+type Layer struct {
+	// Name identifies the layer when explaining where a value came from, such as "defaults" or ".env".
+	Name string
+	// Source is the source of the layer's variables.
+	Source Source
+}
+
+// Resolution describes how a Resolver resolved a variable.
+type Resolution struct {
+	// Key is the name of the variable.
+	Key string
+	// Value is the resolved value.
+	Value string
+	// Layer is the name of the layer that supplied the value. It is empty if the variable was not found.
+	Layer string
+	// Found is true if any layer supplied a value.
+	Found bool
+}
+
+// Resolver is a Source which merges several layers in priority order, and can report which layer supplied each value.
+// This makes it easier to answer "where did this setting come from" when configuration is spread across several places:
+//
+//	dotenv, _ := env.ReadDotEnv(".env")
+//	r := env.NewResolver(
+//		env.Layer{Name: "flags", Source: overrides},
+//		env.Layer{Name: "environment", Source: env.OS()},
+//		env.Layer{Name: ".env", Source: dotenv},
+//		env.Layer{Name: "defaults", Source: env.Map{"PORT": "8080"}},
+//	)
+//	e := env.New(r)
+//
+// Unlike Layered, a Resolver skips layers with a nil Source.
+type Resolver struct {
+	layers []Layer
+}
+
+// NewResolver creates a Resolver from layers, with the highest priority first.
+func NewResolver(layers ...Layer) *Resolver {
+	return &Resolver{layers: layers}
+}
+
+// Lookup returns the value of key from the first layer in which it is set.
+func (r *Resolver) Lookup(key string) (string, bool) {
+	res := r.Resolve(key)
+	return res.Value, res.Found
+}
+
+// Resolve returns the value of key from the first layer in which it is set, along with the name of that layer.
+func (r *Resolver) Resolve(key string) Resolution {
+	for _, l := range r.layers {
+		if l.Source == nil {
+			continue
+		}
+		if v, ok := l.Source.Lookup(key); ok {
+			return Resolution{Key: key, Value: v, Layer: l.Name, Found: true}
+		}
+	}
+	return Resolution{Key: key}
+}
+
+// Explain resolves each of the given keys.
+func (r *Resolver) Explain(keys ...string) []Resolution {
+	result := make([]Resolution, len(keys))
+	for i, key := range keys {
+		result[i] = r.Resolve(key)
+	}
+	return result
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleResolver() {
+	r := NewResolver(
+		Layer{Name: "flags", Source: Map{"LOG_LEVEL": "debug"}},
+		Layer{Name: ".env", Source: Map{"LOG_LEVEL": "info", "PORT": "9000"}},
+		Layer{Name: "defaults", Source: Map{"PORT": "8080", "HOST": "localhost"}},
+	)
+	for _, res := range r.Explain("LOG_LEVEL", "PORT", "HOST", "MISSING") {
+		fmt.Printf("%s=%q from %q (found=%v)\n", res.Key, res.Value, res.Layer, res.Found)
+	}
+	// Output:
+	// LOG_LEVEL="debug" from "flags" (found=true)
+	// PORT="9000" from ".env" (found=true)
+	// HOST="localhost" from "defaults" (found=true)
+	// MISSING="" from "" (found=false)
+}
+
+func TestResolver_nilSource(t *testing.T) {
+	r := NewResolver(Layer{Name: "empty"}, Layer{Name: "map", Source: Map{"A": "1"}})
+	if v, ok := r.Lookup("A"); !ok || v != "1" {
+		t.Errorf("expected=1, got=%v", v)
+	}
+}
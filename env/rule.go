@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+)
+
+// Rule validates the parsed value of a variable, returning an error describing why an invalid value was rejected.
+// Rules can be passed to the error-returning getters, such as GetInt, and are also Options for Var.
+// A value that fails a rule is reported as a *ParseError, so that Registry.Check includes it in its aggregated error.
+type Rule[T any] func(v T) error
+
+func (r Rule[T]) apply(o *varOptions) {
+	o.rules = append(o.rules, r)
+}
+
+// OneOf returns a Rule that accepts only the given values.
+//
+//	level, err := env.Get("LOG_LEVEL", env.OneOf("debug", "info", "warn", "error"))
+func OneOf[T comparable](allowed ...T) Rule[T] {
+	return func(v T) error {
+		if slices.Contains(allowed, v) {
+			return nil
+		}
+		return fmt.Errorf("must be one of %v", allowed)
+	}
+}
+
+// Range returns a Rule that accepts only values between lo and hi, inclusive.
+//
+//	port, err := env.GetInt("PORT", env.Range(1, 65535))
+func Range[T cmp.Ordered](lo, hi T) Rule[T] {
+	return func(v T) error {
+		if v < lo || v > hi {
+			return fmt.Errorf("must be between %v and %v", lo, hi)
+		}
+		return nil
+	}
+}
+
+// Matches returns a Rule that accepts only strings matching re.
+func Matches(re *regexp.Regexp) Rule[string] {
+	return func(v string) error {
+		if !re.MatchString(v) {
+			return fmt.Errorf("must match %s", re)
+		}
+		return nil
+	}
+}
+
+func validate[T any](v T, rules []Rule[T]) error {
+	for _, rule := range rules {
+		if err := rule(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
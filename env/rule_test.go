@@ -0,0 +1,122 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/fault"
+)
+
+func TestRules(t *testing.T) {
+	e := New(Map{"LEVEL": "trace", "PORT": "70000", "NAME": "my-app", "TIMEOUT": "1h", "DEBUG": "true"})
+	tests := []struct {
+		name      string
+		fn        func() error
+		expectMsg string
+	}{
+		{
+			name: "one-of",
+			fn: func() error {
+				_, err := e.Get("LEVEL", OneOf("debug", "info"))
+				return err
+			},
+			expectMsg: `env: invalid value "trace" for LEVEL: must be one of [debug info]`,
+		},
+		{
+			name: "range",
+			fn: func() error {
+				_, err := e.GetInt("PORT", Range(1, 65535))
+				return err
+			},
+			expectMsg: `env: invalid value "70000" for PORT: must be between 1 and 65535`,
+		},
+		{
+			name: "range-duration",
+			fn: func() error {
+				_, err := e.GetDuration("TIMEOUT", Range(time.Second, time.Minute))
+				return err
+			},
+			expectMsg: `env: invalid value "1h" for TIMEOUT: must be between 1s and 1m0s`,
+		},
+		{
+			name: "one-of-bool",
+			fn: func() error {
+				_, err := e.GetBool("DEBUG", OneOf(false))
+				return err
+			},
+			expectMsg: `env: invalid value "true" for DEBUG: must be one of [false]`,
+		},
+		{
+			name: "matches",
+			fn: func() error {
+				_, err := e.Get("NAME", Matches(regexp.MustCompile(`^[a-z]+$`)))
+				return err
+			},
+			expectMsg: `env: invalid value "my-app" for NAME: must match ^[a-z]+$`,
+		},
+		{
+			name: "valid",
+			fn: func() error {
+				_, err := e.Get("NAME", OneOf("my-app"), Matches(regexp.MustCompile(`^[a-z-]+$`)))
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.fn()
+			if tt.expectMsg == "" {
+				if err != nil {
+					t.Errorf("expected no error, got=%v", err)
+				}
+				return
+			}
+			var pe *ParseError
+			if !errors.As(err, &pe) || err.Error() != tt.expectMsg {
+				t.Errorf("expected=%v, got=%v", tt.expectMsg, err)
+			}
+		})
+	}
+}
+
+func TestRules_registry(t *testing.T) {
+	r := NewRegistry(New(Map{"LEVEL": "trace", "PORT": "0"}))
+	VarIn[string](r, "LEVEL", OneOf("debug", "info"))
+	VarIn[int](r, "PORT", Range(1, 65535))
+	VarIn[int](r, "WORKERS", Default(4), Range(1, 16))
+	err := r.Check()
+	if err == nil || !strings.HasPrefix(err.Error(), "2 errors occurred:") {
+		t.Errorf("expected 2 errors, got=%v", err)
+	}
+	panicErr := fault.Catch(func() error {
+		VarIn[int](r, "PORT", OneOf("80"))
+		return nil
+	})
+	if panicErr == nil {
+		t.Errorf("expected panic for mismatched rule type")
+	}
+}
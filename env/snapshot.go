@@ -0,0 +1,87 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"os"
+	"strings"
+)
+
+// Snapshot returns a copy of the environment of the current process.
+// The returned Map can be used as a Source, or passed to Restore to reset the environment later.
+func Snapshot() Map {
+	environ := os.Environ()
+	m := make(Map, len(environ))
+	for _, kv := range environ {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			m[k] = v
+		}
+	}
+	return m
+}
+
+// Restore replaces the environment of the current process with the variables in m,
+// unsetting any variable that is not in m.
+func Restore(m Map) error {
+	for k := range Snapshot() {
+		if _, ok := m[k]; !ok {
+			if err := os.Unsetenv(k); err != nil {
+				return err
+			}
+		}
+	}
+	for k, v := range m {
+		if err := os.Setenv(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TB is the subset of testing.TB used by WithVars.
+type TB interface {
+	Helper()
+	Cleanup(func())
+	Fatalf(format string, args ...any)
+}
+
+// WithVars sets the given variables in the environment of the current process for the duration of a test,
+// and restores their previous values, or unsets them, when the test and its subtests complete.
+//
+// Like testing.T.Setenv, it affects the whole process, so it must not be used in parallel tests.
+// It is intended for legacy code that still reads os.Getenv; new code should accept a Source instead.
+func WithVars(t TB, vars map[string]string) {
+	t.Helper()
+	for k, v := range vars {
+		prev, ok := os.LookupEnv(k)
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatalf("env: failed to set %s: %v", k, err)
+		}
+		t.Cleanup(func() {
+			if ok {
+				_ = os.Setenv(k, prev)
+			} else {
+				_ = os.Unsetenv(k)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSnapshot(t *testing.T) {
+	t.Setenv("TEST_ENV_SNAPSHOT", "before")
+	_ = os.Unsetenv("TEST_ENV_SNAPSHOT_NEW")
+	snap := Snapshot()
+	if v, ok := snap.Lookup("TEST_ENV_SNAPSHOT"); !ok || v != "before" {
+		t.Fatalf("expected=before, got=%v", v)
+	}
+	_ = os.Setenv("TEST_ENV_SNAPSHOT", "after")
+	_ = os.Setenv("TEST_ENV_SNAPSHOT_NEW", "new")
+	if err := Restore(snap); err != nil {
+		t.Fatalf("Restore: %v", err)
+	}
+	if v := os.Getenv("TEST_ENV_SNAPSHOT"); v != "before" {
+		t.Errorf("expected=before, got=%v", v)
+	}
+	if _, ok := os.LookupEnv("TEST_ENV_SNAPSHOT_NEW"); ok {
+		t.Errorf("expected TEST_ENV_SNAPSHOT_NEW to be unset")
+	}
+}
+
+func TestWithVars(t *testing.T) {
+	t.Setenv("TEST_ENV_WITH_A", "original")
+	_ = os.Unsetenv("TEST_ENV_WITH_B")
+	t.Run("scoped", func(t *testing.T) {
+		WithVars(t, map[string]string{"TEST_ENV_WITH_A": "a", "TEST_ENV_WITH_B": "b"})
+		if v := os.Getenv("TEST_ENV_WITH_A"); v != "a" {
+			t.Errorf("expected=a, got=%v", v)
+		}
+		if v := os.Getenv("TEST_ENV_WITH_B"); v != "b" {
+			t.Errorf("expected=b, got=%v", v)
+		}
+	})
+	if v := os.Getenv("TEST_ENV_WITH_A"); v != "original" {
+		t.Errorf("expected=original, got=%v", v)
+	}
+	if _, ok := os.LookupEnv("TEST_ENV_WITH_B"); ok {
+		t.Errorf("expected TEST_ENV_WITH_B to be unset")
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import "os"
+
+// Source is a source of environment variables.
+type Source interface {
+	// Lookup returns the value of the variable named by key, and whether it is set.
+	Lookup(key string) (string, bool)
+}
+
+// OS returns a Source that reads the environment of the current process.
+func OS() Source {
+	return osSource{}
+}
+
+type osSource struct{}
+
+func (osSource) Lookup(key string) (string, bool) {
+	return os.LookupEnv(key)
+}
+
+// Map is a Source backed by a map, which is useful in tests.
+type Map map[string]string
+
+// Lookup returns the value of key in the map.
+func (m Map) Lookup(key string) (string, bool) {
+	v, ok := m[key]
+	return v, ok
+}
+
+// Layered is a Source that looks up variables in each of its Sources in order, returning the first value found.
+// This can be used to override some variables of another Source:
+//
+//	src := env.Layered{env.Map{"LOG_LEVEL": "debug"}, env.OS()}
+type Layered []Source
+
+// Lookup returns the value of key from the first Source in which it is set.
+func (l Layered) Lookup(key string) (string, bool) {
+	for _, src := range l {
+		if v, ok := src.Lookup(key); ok {
+			return v, true
+		}
+	}
+	return "", false
+}
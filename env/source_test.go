@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func ExampleEnv() {
+	e := New(Map{"PORT": "8080"})
+	port, err := e.GetInt("PORT")
+	fmt.Println(port, err)
+	fmt.Println(e.GetWithDefault("HOST", "localhost"))
+	// Output:
+	// 8080 <nil>
+	// localhost
+}
+
+func TestLayered(t *testing.T) {
+	src := Layered{Map{"A": "1"}, Map{"A": "2", "B": "2"}}
+	tests := []struct {
+		key    string
+		expect string
+		ok     bool
+	}{
+		{key: "A", expect: "1", ok: true},
+		{key: "B", expect: "2", ok: true},
+		{key: "C", expect: "", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			v, ok := src.Lookup(tt.key)
+			if v != tt.expect || ok != tt.ok {
+				t.Errorf("expected=(%v,%v), got=(%v,%v)", tt.expect, tt.ok, v, ok)
+			}
+		})
+	}
+}
+
+func TestEnv(t *testing.T) {
+	e := New(Map{"NAME": "got", "EMPTY": "", "COUNT": "3", "BAD": "x"})
+	if v := e.GetWithDefault("EMPTY", "def"); v != "def" {
+		t.Errorf("GetWithDefault: expected=def, got=%v", v)
+	}
+	lv := e.Lookup("EMPTY")
+	if v, ok := lv.Get(); !ok || v != "" {
+		t.Errorf("Lookup: expected empty valid value, got=%v", lv)
+	}
+	if v, err := e.GetInt("COUNT"); err != nil || v != 3 {
+		t.Errorf("GetInt: expected=3, got=%v (err=%v)", v, err)
+	}
+	ov, err := LookupAsIn(e, "BAD", strconv.Atoi)
+	testCheckErr(t, err, &ParseError{})
+	if ov.IsValid() {
+		t.Errorf("LookupAsIn: expected Nothing, got=%v", ov)
+	}
+	if err := e.Require("NAME", "EMPTY", "MISSING"); !errors.Is(err, ErrNotSet) {
+		t.Errorf("Require: expected ErrNotSet, got=%v", err)
+	}
+}
+
+func TestEnv_nil(t *testing.T) {
+	t.Setenv("TEST_ENV_NIL", "value")
+	var e *Env
+	if v := e.GetWithDefault("TEST_ENV_NIL", "def"); v != "value" {
+		t.Errorf("expected=value, got=%v", v)
+	}
+}
+
+func TestEnv_fileIndirection(t *testing.T) {
+	e := New(Map{"TOKEN_FILE": testWriteFile(t, "abc\n")})
+	if v := e.MustGet("TOKEN"); v != "abc" {
+		t.Errorf("expected=abc, got=%v", v)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/justenwalker/got/fault"
+)
+
+// ErrNotSet is returned when a required environment variable is not set.
+const ErrNotSet = fault.Message("env: variable not set")
+
+// ParseError is returned when the value of an environment variable cannot be parsed.
+type ParseError struct {
+	// Key is the name of the environment variable.
+	Key string
+	// Value is the value that could not be parsed.
+	Value string
+	// Err is the underlying parse error.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("env: invalid value %q for %s: %v", e.Value, e.Key, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// GetInt returns the value of an environment variable parsed as an int.
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it is not a valid int
+// or does not satisfy the rules.
+func (e *Env) GetInt(key string, rules ...Rule[int]) (int, error) {
+	return getAs(e, key, strconv.Atoi, rules...)
+}
+
+// GetIntWithDefault returns the value of an environment variable parsed as an int,
+// or the provided default if the environment was not set or is not a valid int.
+func (e *Env) GetIntWithDefault(key string, def int) int {
+	return getAsWithDefault(e, key, def, strconv.Atoi)
+}
+
+// GetBool returns the value of an environment variable parsed as a bool, using strconv.ParseBool.
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it is not a valid bool
+// or does not satisfy the rules.
+func (e *Env) GetBool(key string, rules ...Rule[bool]) (bool, error) {
+	return getAs(e, key, strconv.ParseBool, rules...)
+}
+
+// GetBoolWithDefault returns the value of an environment variable parsed as a bool,
+// or the provided default if the environment was not set or is not a valid bool.
+func (e *Env) GetBoolWithDefault(key string, def bool) bool {
+	return getAsWithDefault(e, key, def, strconv.ParseBool)
+}
+
+// GetFloat64 returns the value of an environment variable parsed as a float64.
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it is not a valid float
+// or does not satisfy the rules.
+func (e *Env) GetFloat64(key string, rules ...Rule[float64]) (float64, error) {
+	return getAs(e, key, parseFloat64, rules...)
+}
+
+// GetFloat64WithDefault returns the value of an environment variable parsed as a float64,
+// or the provided default if the environment was not set or is not a valid float.
+func (e *Env) GetFloat64WithDefault(key string, def float64) float64 {
+	return getAsWithDefault(e, key, def, parseFloat64)
+}
+
+// GetDuration returns the value of an environment variable parsed with time.ParseDuration.
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it is not a valid duration
+// or does not satisfy the rules.
+func (e *Env) GetDuration(key string, rules ...Rule[time.Duration]) (time.Duration, error) {
+	return getAs(e, key, time.ParseDuration, rules...)
+}
+
+// GetDurationWithDefault returns the value of an environment variable parsed with time.ParseDuration,
+// or the provided default if the environment was not set or is not a valid duration.
+func (e *Env) GetDurationWithDefault(key string, def time.Duration) time.Duration {
+	return getAsWithDefault(e, key, def, time.ParseDuration)
+}
+
+// GetInt is like Env.GetInt, reading the process environment.
+func GetInt(key string, rules ...Rule[int]) (int, error) {
+	return std.GetInt(key, rules...)
+}
+
+// GetIntWithDefault is like Env.GetIntWithDefault, reading the process environment.
+func GetIntWithDefault(key string, def int) int {
+	return std.GetIntWithDefault(key, def)
+}
+
+// GetBool is like Env.GetBool, reading the process environment.
+func GetBool(key string, rules ...Rule[bool]) (bool, error) {
+	return std.GetBool(key, rules...)
+}
+
+// GetBoolWithDefault is like Env.GetBoolWithDefault, reading the process environment.
+func GetBoolWithDefault(key string, def bool) bool {
+	return std.GetBoolWithDefault(key, def)
+}
+
+// GetFloat64 is like Env.GetFloat64, reading the process environment.
+func GetFloat64(key string, rules ...Rule[float64]) (float64, error) {
+	return std.GetFloat64(key, rules...)
+}
+
+// GetFloat64WithDefault is like Env.GetFloat64WithDefault, reading the process environment.
+func GetFloat64WithDefault(key string, def float64) float64 {
+	return std.GetFloat64WithDefault(key, def)
+}
+
+// GetDuration is like Env.GetDuration, reading the process environment.
+func GetDuration(key string, rules ...Rule[time.Duration]) (time.Duration, error) {
+	return std.GetDuration(key, rules...)
+}
+
+// GetDurationWithDefault is like Env.GetDurationWithDefault, reading the process environment.
+func GetDurationWithDefault(key string, def time.Duration) time.Duration {
+	return std.GetDurationWithDefault(key, def)
+}
+
+func errNotSet(key string) error {
+	return fmt.Errorf("%w: %s", ErrNotSet, key)
+}
+
+func parseFloat64(s string) (float64, error) {
+	return strconv.ParseFloat(s, 64)
+}
+
+func getAs[T any](e *Env, key string, parse func(string) (T, error), rules ...Rule[T]) (T, error) {
+	var zero T
+	s, _, err := e.lookupEnv(key)
+	if err != nil {
+		return zero, err
+	}
+	if s == "" {
+		return zero, errNotSet(key)
+	}
+	v, err := parse(s)
+	if err != nil {
+		return zero, &ParseError{Key: key, Value: s, Err: err}
+	}
+	if err = validate(v, rules); err != nil {
+		return zero, &ParseError{Key: key, Value: s, Err: err}
+	}
+	return v, nil
+}
+
+func getAsWithDefault[T any](e *Env, key string, def T, parse func(string) (T, error)) T {
+	v, err := getAs(e, key, parse)
+	if err != nil {
+		return def
+	}
+	return v
+}
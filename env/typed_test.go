@@ -0,0 +1,114 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGetInt(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		expect    int
+		expectErr error
+	}{
+		{name: "unset", value: "", expectErr: ErrNotSet},
+		{name: "valid", value: "42", expect: 42},
+		{name: "negative", value: "-7", expect: -7},
+		{name: "invalid", value: "abc", expectErr: &ParseError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_ENV_INT", tt.value)
+			actual, err := GetInt("TEST_ENV_INT")
+			testCheckErr(t, err, tt.expectErr)
+			if actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+			if tt.expectErr == nil {
+				return
+			}
+			if def := GetIntWithDefault("TEST_ENV_INT", 5); def != 5 {
+				t.Errorf("expected default=%v, got=%v", 5, def)
+			}
+		})
+	}
+}
+
+func TestGetBool(t *testing.T) {
+	t.Setenv("TEST_ENV_BOOL", "true")
+	if v, err := GetBool("TEST_ENV_BOOL"); err != nil || !v {
+		t.Errorf("expected=true, got=%v (err=%v)", v, err)
+	}
+	t.Setenv("TEST_ENV_BOOL", "nope")
+	_, err := GetBool("TEST_ENV_BOOL")
+	testCheckErr(t, err, &ParseError{})
+	if v := GetBoolWithDefault("TEST_ENV_BOOL", true); !v {
+		t.Errorf("expected=true, got=%v", v)
+	}
+}
+
+func TestGetFloat64(t *testing.T) {
+	t.Setenv("TEST_ENV_FLOAT", "1.5")
+	if v, err := GetFloat64("TEST_ENV_FLOAT"); err != nil || v != 1.5 {
+		t.Errorf("expected=1.5, got=%v (err=%v)", v, err)
+	}
+	if v := GetFloat64WithDefault("TEST_ENV_FLOAT_UNSET", 2.5); v != 2.5 {
+		t.Errorf("expected=2.5, got=%v", v)
+	}
+}
+
+func TestGetDuration(t *testing.T) {
+	t.Setenv("TEST_ENV_DURATION", "1m30s")
+	if v, err := GetDuration("TEST_ENV_DURATION"); err != nil || v != 90*time.Second {
+		t.Errorf("expected=1m30s, got=%v (err=%v)", v, err)
+	}
+	t.Setenv("TEST_ENV_DURATION", "90")
+	_, err := GetDuration("TEST_ENV_DURATION")
+	testCheckErr(t, err, &ParseError{})
+	if err.Error() != `env: invalid value "90" for TEST_ENV_DURATION: time: missing unit in duration "90"` {
+		t.Errorf("unexpected error message: %v", err)
+	}
+	if v := GetDurationWithDefault("TEST_ENV_DURATION", time.Second); v != time.Second {
+		t.Errorf("expected=1s, got=%v", v)
+	}
+}
+
+func testCheckErr(t *testing.T, err error, expect error) {
+	t.Helper()
+	var pe *ParseError
+	switch {
+	case expect == nil:
+		if err != nil {
+			t.Errorf("expected no error, got=%v", err)
+		}
+	case errors.As(expect, &pe):
+		if !errors.As(err, &pe) {
+			t.Errorf("expected *ParseError, got=%v", err)
+		}
+	case !errors.Is(err, expect):
+		t.Errorf("expected=%v, got=%v", expect, err)
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"errors"
+	"net/netip"
+	"net/url"
+	"time"
+)
+
+// GetURL returns the value of an environment variable parsed as an absolute URL with a host, such as "https://example.com/api".
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it is not a valid URL.
+func (e *Env) GetURL(key string) (*url.URL, error) {
+	return getAs(e, key, parseURL)
+}
+
+// GetIP returns the value of an environment variable parsed as an IPv4 or IPv6 address.
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it is not a valid address.
+func (e *Env) GetIP(key string) (netip.Addr, error) {
+	return getAs(e, key, netip.ParseAddr)
+}
+
+// GetCIDR returns the value of an environment variable parsed as an IP network in CIDR notation, such as "10.0.0.0/8".
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it is not a valid prefix.
+func (e *Env) GetCIDR(key string) (netip.Prefix, error) {
+	return getAs(e, key, netip.ParsePrefix)
+}
+
+// GetTime returns the value of an environment variable parsed with time.Parse using the given layout.
+// It returns an error wrapping ErrNotSet if the variable is not set, or a *ParseError if it does not match the layout.
+func (e *Env) GetTime(key string, layout string) (time.Time, error) {
+	return getAs(e, key, func(s string) (time.Time, error) {
+		return time.Parse(layout, s)
+	})
+}
+
+// GetURL is like Env.GetURL, reading the process environment.
+func GetURL(key string) (*url.URL, error) {
+	return std.GetURL(key)
+}
+
+// GetIP is like Env.GetIP, reading the process environment.
+func GetIP(key string) (netip.Addr, error) {
+	return std.GetIP(key)
+}
+
+// GetCIDR is like Env.GetCIDR, reading the process environment.
+func GetCIDR(key string) (netip.Prefix, error) {
+	return std.GetCIDR(key)
+}
+
+// GetTime is like Env.GetTime, reading the process environment.
+func GetTime(key string, layout string) (time.Time, error) {
+	return std.GetTime(key, layout)
+}
+
+var errNotAbsoluteURL = errors.New("URL must be absolute and include a host")
+
+func parseURL(s string) (*url.URL, error) {
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	if !u.IsAbs() || u.Host == "" {
+		return nil, errNotAbsoluteURL
+	}
+	return u, nil
+}
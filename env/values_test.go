@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		value     string
+		expect    string
+		expectErr error
+	}{
+		{name: "unset", value: "", expectErr: ErrNotSet},
+		{name: "valid", value: "https://example.com/api?x=1", expect: "https://example.com/api?x=1"},
+		{name: "relative", value: "/api", expectErr: &ParseError{}},
+		{name: "no-host", value: "mailto:someone@example.com", expectErr: &ParseError{}},
+		{name: "invalid", value: "http://[::1", expectErr: &ParseError{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := New(Map{"URL": tt.value}).GetURL("URL")
+			testCheckErr(t, err, tt.expectErr)
+			if err == nil && u.String() != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, u)
+			}
+		})
+	}
+}
+
+func TestGetIP(t *testing.T) {
+	e := New(Map{"V4": "10.1.2.3", "V6": "::1", "BAD": "10.1.2"})
+	if ip, err := e.GetIP("V4"); err != nil || ip.String() != "10.1.2.3" {
+		t.Errorf("expected=10.1.2.3, got=%v (err=%v)", ip, err)
+	}
+	if ip, err := e.GetIP("V6"); err != nil || !ip.Is6() {
+		t.Errorf("expected=::1, got=%v (err=%v)", ip, err)
+	}
+	_, err := e.GetIP("BAD")
+	testCheckErr(t, err, &ParseError{})
+}
+
+func TestGetCIDR(t *testing.T) {
+	e := New(Map{"NET": "10.0.0.0/8", "BAD": "10.0.0.0"})
+	p, err := e.GetCIDR("NET")
+	if err != nil || p.String() != "10.0.0.0/8" {
+		t.Errorf("expected=10.0.0.0/8, got=%v (err=%v)", p, err)
+	}
+	_, err = e.GetCIDR("BAD")
+	testCheckErr(t, err, &ParseError{})
+	if err.Error() != `env: invalid value "10.0.0.0" for BAD: netip.ParsePrefix("10.0.0.0"): no '/'` {
+		t.Errorf("unexpected error message: %v", err)
+	}
+}
+
+func TestGetTime(t *testing.T) {
+	e := New(Map{"DATE": "2024-03-01", "BAD": "03/01/2024"})
+	tm, err := e.GetTime("DATE", time.DateOnly)
+	if err != nil || !tm.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected=2024-03-01, got=%v (err=%v)", tm, err)
+	}
+	_, err = e.GetTime("BAD", time.DateOnly)
+	testCheckErr(t, err, &ParseError{})
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"context"
+	"time"
+
+	"github.com/justenwalker/got/clock"
+	"github.com/justenwalker/got/optional"
+)
+
+// DefaultWatchInterval is the interval used by Watch when the given interval is not positive.
+const DefaultWatchInterval = time.Second
+
+// Change describes a change in the value of a variable observed by Watch.
+type Change struct {
+	// Key is the name of the variable.
+	Key string
+	// Old is the previous value, or Nothing if the variable was not set.
+	Old optional.Value[string]
+	// New is the current value, or Nothing if the variable is no longer set.
+	New optional.Value[string]
+}
+
+// Watch polls the given variables every interval, and sends a Change on the returned channel
+// whenever one of their values changes, including being set or unset. The current values are
+// recorded when Watch is called, and are not sent.
+//
+// Variables are looked up in the same way as Lookup, so a variable whose value is read through
+// FileSuffix indirection changes when the contents of the file change.
+// The interval is measured by the clock carried by ctx; see clock.NewContext.
+// If interval is not positive, DefaultWatchInterval is used.
+// The channel is closed when ctx is done.
+func (e *Env) Watch(ctx context.Context, interval time.Duration, keys ...string) <-chan Change {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	ch := make(chan Change)
+	current := make([]optional.Value[string], len(keys))
+	for i, key := range keys {
+		current[i] = e.Lookup(key)
+	}
+	go func() {
+		defer close(ch)
+		ticker := clock.FromContext(ctx).NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C():
+			}
+			for i, key := range keys {
+				v := e.Lookup(key)
+				if v == current[i] {
+					continue
+				}
+				change := Change{Key: key, Old: current[i], New: v}
+				current[i] = v
+				select {
+				case ch <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch
+}
+
+// Watch is like Env.Watch, reading the process environment.
+func Watch(ctx context.Context, interval time.Duration, keys ...string) <-chan Change {
+	return std.Watch(ctx, interval, keys...)
+}
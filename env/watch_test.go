@@ -0,0 +1,123 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package env
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/clock"
+	"github.com/justenwalker/got/optional"
+)
+
+type testMutableSource struct {
+	mu   sync.Mutex
+	vars Map
+}
+
+func (s *testMutableSource) Lookup(key string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.vars.Lookup(key)
+}
+
+func (s *testMutableSource) set(key string, value string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.vars[key] = value
+}
+
+func (s *testMutableSource) unset(key string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.vars, key)
+}
+
+func TestWatch(t *testing.T) {
+	src := &testMutableSource{vars: Map{"A": "1"}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := New(src).Watch(ctx, time.Millisecond, "A", "B")
+	expectChange := func(expect Change) {
+		t.Helper()
+		select {
+		case actual := <-ch:
+			if actual != expect {
+				t.Errorf("expected=%+v, got=%+v", expect, actual)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %+v", expect)
+		}
+	}
+	src.set("A", "2")
+	expectChange(Change{Key: "A", Old: optional.New("1"), New: optional.New("2")})
+	src.set("B", "x")
+	expectChange(Change{Key: "B", Old: optional.Nothing[string](), New: optional.New("x")})
+	src.unset("A")
+	expectChange(Change{Key: "A", Old: optional.New("2"), New: optional.Nothing[string]()})
+	cancel()
+	select {
+	case _, ok := <-ch:
+		for ok {
+			_, ok = <-ch
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected channel to be closed")
+	}
+}
+
+func TestWatch_fakeClock(t *testing.T) {
+	src := &testMutableSource{vars: Map{"A": "1"}}
+	clk := clock.NewFake(time.Unix(0, 0))
+	ctx, cancel := context.WithCancel(clock.NewContext(context.Background(), clk))
+	defer cancel()
+	ch := New(src).Watch(ctx, time.Hour, "A")
+	clk.BlockUntil(1)
+	src.set("A", "2")
+	select {
+	case actual := <-ch:
+		t.Fatalf("expected no change before the interval, got=%+v", actual)
+	case <-time.After(10 * time.Millisecond):
+	}
+	clk.Advance(time.Hour)
+	expect := Change{Key: "A", Old: optional.New("1"), New: optional.New("2")}
+	if actual := <-ch; actual != expect {
+		t.Errorf("expected=%+v, got=%+v", expect, actual)
+	}
+}
+
+func TestWatch_defaultInterval(t *testing.T) {
+	src := &testMutableSource{vars: Map{"A": "1"}}
+	clk := clock.NewFake(time.Unix(0, 0))
+	ctx, cancel := context.WithCancel(clock.NewContext(context.Background(), clk))
+	defer cancel()
+	ch := New(src).Watch(ctx, 0, "A")
+	clk.BlockUntil(1)
+	src.set("A", "2")
+	clk.Advance(DefaultWatchInterval)
+	expect := Change{Key: "A", Old: optional.New("1"), New: optional.New("2")}
+	if actual := <-ch; actual != expect {
+		t.Errorf("expected=%+v, got=%+v", expect, actual)
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package eventbus dispatches events to handlers registered by event type, to decouple modules within one program.
+//
+//	bus := eventbus.New()
+//	eventbus.On(bus, func(ctx context.Context, e UserCreated) error {
+//	    return sendWelcomeEmail(ctx, e.Email)
+//	})
+//	err := bus.Emit(ctx, UserCreated{Email: "user@example.com"})
+package eventbus
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/justenwalker/got/fault"
+)
+
+// Option configures a Bus.
+type Option func(*Bus)
+
+// WithAsync makes the Bus dispatch events asynchronously: Emit calls each handler in its own goroutine
+// and returns without waiting for them. Errors returned by the handlers are passed to the error handler.
+func WithAsync() Option {
+	return func(b *Bus) {
+		b.async = true
+	}
+}
+
+// WithErrorHandler sets a function which receives the errors of handlers called asynchronously.
+// By default, they are discarded.
+func WithErrorHandler(fn func(err error)) Option {
+	return func(b *Bus) {
+		b.onError = fn
+	}
+}
+
+// Bus dispatches events to the handlers registered for their type with On.
+// A Bus must be created with New, and is safe for concurrent use.
+type Bus struct {
+	async   bool
+	onError func(err error)
+
+	mu       sync.RWMutex
+	handlers []*handler
+	wg       sync.WaitGroup
+}
+
+type handler struct {
+	typ reflect.Type
+	fn  func(ctx context.Context, event any) error
+}
+
+// New creates a Bus, which dispatches events synchronously unless WithAsync is used.
+func New(opts ...Option) *Bus {
+	b := &Bus{}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+// On registers fn to handle events of type E, and returns a function which unregisters it.
+//
+// If E is an interface type, fn handles every event which implements it. Otherwise, it only handles events of exactly type E.
+func On[E any](b *Bus, fn func(ctx context.Context, event E) error) (off func()) {
+	h := &handler{
+		typ: reflect.TypeFor[E](),
+		fn: func(ctx context.Context, event any) error {
+			return fn(ctx, event.(E))
+		},
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.handlers = append(b.handlers, h)
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.handlers = slices.DeleteFunc(b.handlers, func(other *handler) bool { return other == h })
+	}
+}
+
+// Emit dispatches event to every handler registered for its type, in the order they were registered.
+//
+// In synchronous mode, Emit calls the handlers one at a time and returns their errors as a fault.List.
+// A handler that panics does not prevent the others from running: the panic is recovered and returned as a *fault.PanicError.
+// In asynchronous mode, Emit returns nil without waiting for the handlers.
+func (b *Bus) Emit(ctx context.Context, event any) error {
+	typ := reflect.TypeOf(event)
+	b.mu.RLock()
+	var matched []*handler
+	for _, h := range b.handlers {
+		if typ == h.typ || (typ != nil && h.typ.Kind() == reflect.Interface && typ.Implements(h.typ)) {
+			matched = append(matched, h)
+		}
+	}
+	b.mu.RUnlock()
+	if b.async {
+		for _, h := range matched {
+			b.wg.Add(1)
+			go func() {
+				defer b.wg.Done()
+				if err := h.call(ctx, event); err != nil && b.onError != nil {
+					b.onError(err)
+				}
+			}()
+		}
+		return nil
+	}
+	var errs fault.List
+	for _, h := range matched {
+		errs.Append(h.call(ctx, event))
+	}
+	return errs.Err()
+}
+
+// Wait waits for the handlers called asynchronously by Emit to return.
+func (b *Bus) Wait() {
+	b.wg.Wait()
+}
+
+func (h *handler) call(ctx context.Context, event any) (err error) {
+	defer fault.Recover(&err)
+	if err = h.fn(ctx, event); err != nil {
+		return fmt.Errorf("eventbus: %v: %w", h.typ, err)
+	}
+	return nil
+}
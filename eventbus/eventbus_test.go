@@ -0,0 +1,124 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/justenwalker/got/fault"
+)
+
+type userCreated struct {
+	Name string
+}
+
+func (e userCreated) String() string {
+	return "user created: " + e.Name
+}
+
+type userDeleted struct {
+	Name string
+}
+
+func ExampleOn() {
+	bus := New()
+	On(bus, func(_ context.Context, e userCreated) error {
+		fmt.Println("welcome", e.Name)
+		return nil
+	})
+	On(bus, func(_ context.Context, e fmt.Stringer) error {
+		fmt.Println("audit:", e)
+		return nil
+	})
+	_ = bus.Emit(context.Background(), userCreated{Name: "gopher"})
+	_ = bus.Emit(context.Background(), userDeleted{Name: "gopher"})
+	// Output:
+	// welcome gopher
+	// audit: user created: gopher
+}
+
+func TestBus_Emit(t *testing.T) {
+	errHandler := errors.New("handler failed")
+	bus := New()
+	var calls []string
+	On(bus, func(context.Context, userCreated) error {
+		calls = append(calls, "first")
+		return errHandler
+	})
+	On(bus, func(context.Context, userCreated) error {
+		calls = append(calls, "panics")
+		panic("boom")
+	})
+	off := On(bus, func(context.Context, userCreated) error {
+		calls = append(calls, "removed")
+		return nil
+	})
+	On(bus, func(context.Context, userCreated) error {
+		calls = append(calls, "last")
+		return nil
+	})
+	off()
+	err := bus.Emit(context.Background(), userCreated{})
+	if !slices.Equal(calls, []string{"first", "panics", "last"}) {
+		t.Errorf("expected=[first panics last], got=%v", calls)
+	}
+	if !errors.Is(err, errHandler) || !fault.Has[*fault.PanicError](err) {
+		t.Errorf("expected handler error and panic, got=%v", err)
+	}
+	if err := bus.Emit(context.Background(), userDeleted{}); err != nil {
+		t.Errorf("expected no handlers, got=%v", err)
+	}
+}
+
+func TestBus_async(t *testing.T) {
+	var mu sync.Mutex
+	var errs []error
+	bus := New(WithAsync(), WithErrorHandler(func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		errs = append(errs, err)
+	}))
+	var wg sync.WaitGroup
+	wg.Add(1)
+	On(bus, func(context.Context, userCreated) error {
+		wg.Wait()
+		return errors.New("failed")
+	})
+	On(bus, func(context.Context, userCreated) error {
+		panic("boom")
+	})
+	if err := bus.Emit(context.Background(), userCreated{}); err != nil {
+		t.Errorf("expected=<nil>, got=%v", err)
+	}
+	wg.Done()
+	bus.Wait()
+	mu.Lock()
+	defer mu.Unlock()
+	if len(errs) != 2 {
+		t.Errorf("expected=2 errors, got=%v", errs)
+	}
+}
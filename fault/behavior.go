@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+)
+
+// IsConflict checks if the error or any of its wrapped errors is a Conflict error.
+// a Conflict error implements the function `Conflict() bool` and returns true.
+func IsConflict(err error) bool {
+	var asErr interface{ Conflict() bool }
+	return errors.As(err, &asErr) && asErr.Conflict()
+}
+
+// IsAlreadyExists checks if the error or any of its wrapped errors is an AlreadyExists error.
+// an AlreadyExists error implements the function `AlreadyExists() bool` and returns true.
+func IsAlreadyExists(err error) bool {
+	var asErr interface{ AlreadyExists() bool }
+	return errors.As(err, &asErr) && asErr.AlreadyExists()
+}
+
+// IsUnauthorized checks if the error or any of its wrapped errors is an Unauthorized error.
+// an Unauthorized error implements the function `Unauthorized() bool` and returns true.
+func IsUnauthorized(err error) bool {
+	var asErr interface{ Unauthorized() bool }
+	return errors.As(err, &asErr) && asErr.Unauthorized()
+}
+
+// IsForbidden checks if the error or any of its wrapped errors is a Forbidden error.
+// a Forbidden error implements the function `Forbidden() bool` and returns true.
+func IsForbidden(err error) bool {
+	var asErr interface{ Forbidden() bool }
+	return errors.As(err, &asErr) && asErr.Forbidden()
+}
+
+// IsInvalidInput checks if the error or any of its wrapped errors is an InvalidInput error.
+// an InvalidInput error implements the function `InvalidInput() bool` and returns true.
+func IsInvalidInput(err error) bool {
+	var asErr interface{ InvalidInput() bool }
+	return errors.As(err, &asErr) && asErr.InvalidInput()
+}
+
+// IsUnavailable checks if the error or any of its wrapped errors is an Unavailable error.
+// an Unavailable error implements the function `Unavailable() bool` and returns true.
+func IsUnavailable(err error) bool {
+	var asErr interface{ Unavailable() bool }
+	return errors.As(err, &asErr) && asErr.Unavailable()
+}
+
+// NotFoundf creates an error for which IsNotFound reports true, with CodeNotFound.
+// The message is formatted with fmt.Errorf, so %w may be used to wrap another error.
+func NotFoundf(format string, args ...any) error {
+	return newCodedf(CodeNotFound, format, args...)
+}
+
+// Conflictf creates an error for which IsConflict reports true, with CodeConflict.
+// The message is formatted with fmt.Errorf, so %w may be used to wrap another error.
+func Conflictf(format string, args ...any) error {
+	return newCodedf(CodeConflict, format, args...)
+}
+
+// AlreadyExistsf creates an error for which IsAlreadyExists reports true, with CodeAlreadyExists.
+// The message is formatted with fmt.Errorf, so %w may be used to wrap another error.
+func AlreadyExistsf(format string, args ...any) error {
+	return newCodedf(CodeAlreadyExists, format, args...)
+}
+
+// Unauthorizedf creates an error for which IsUnauthorized reports true, with CodeUnauthenticated.
+// The message is formatted with fmt.Errorf, so %w may be used to wrap another error.
+func Unauthorizedf(format string, args ...any) error {
+	return newCodedf(CodeUnauthenticated, format, args...)
+}
+
+// Forbiddenf creates an error for which IsForbidden reports true, with CodePermissionDenied.
+// The message is formatted with fmt.Errorf, so %w may be used to wrap another error.
+func Forbiddenf(format string, args ...any) error {
+	return newCodedf(CodePermissionDenied, format, args...)
+}
+
+// InvalidInputf creates an error for which IsInvalidInput reports true, with CodeInvalidArgument.
+// The message is formatted with fmt.Errorf, so %w may be used to wrap another error.
+func InvalidInputf(format string, args ...any) error {
+	return newCodedf(CodeInvalidArgument, format, args...)
+}
+
+// Unavailablef creates an error for which IsUnavailable reports true, with CodeUnavailable.
+// The message is formatted with fmt.Errorf, so %w may be used to wrap another error.
+func Unavailablef(format string, args ...any) error {
+	return newCodedf(CodeUnavailable, format, args...)
+}
+
+func newCodedf(code Code, format string, args ...any) error {
+	return &codedError{code: code, err: fmt.Errorf(format, args...), wrapped: true}
+}
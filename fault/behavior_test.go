@@ -0,0 +1,120 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type testConflictError struct{}
+
+func (testConflictError) Error() string  { return "test" }
+func (testConflictError) Conflict() bool { return true }
+
+func ExampleConflictf() {
+	err := Conflictf("user %q was modified concurrently", "alice")
+	err = fmt.Errorf("update: %w", err)
+	fmt.Println(err)
+	fmt.Println(IsConflict(err), CodeOf(err))
+	// Output:
+	// update: user "alice" was modified concurrently
+	// true conflict
+}
+
+func TestBehaviorConstructors(t *testing.T) {
+	predicates := map[string]func(error) bool{
+		"IsNotFound":      IsNotFound,
+		"IsConflict":      IsConflict,
+		"IsAlreadyExists": IsAlreadyExists,
+		"IsUnauthorized":  IsUnauthorized,
+		"IsForbidden":     IsForbidden,
+		"IsInvalidInput":  IsInvalidInput,
+		"IsUnavailable":   IsUnavailable,
+	}
+	tests := []struct {
+		name      string
+		errVal    error
+		predicate string
+		code      Code
+	}{
+		{name: "not-found", errVal: NotFoundf("x %d", 1), predicate: "IsNotFound", code: CodeNotFound},
+		{name: "conflict", errVal: Conflictf("x %d", 1), predicate: "IsConflict", code: CodeConflict},
+		{name: "already-exists", errVal: AlreadyExistsf("x %d", 1), predicate: "IsAlreadyExists", code: CodeAlreadyExists},
+		{name: "unauthorized", errVal: Unauthorizedf("x %d", 1), predicate: "IsUnauthorized", code: CodeUnauthenticated},
+		{name: "forbidden", errVal: Forbiddenf("x %d", 1), predicate: "IsForbidden", code: CodePermissionDenied},
+		{name: "invalid-input", errVal: InvalidInputf("x %d", 1), predicate: "IsInvalidInput", code: CodeInvalidArgument},
+		{name: "unavailable", errVal: Unavailablef("x %d", 1), predicate: "IsUnavailable", code: CodeUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fmt.Errorf("wrap: %w", tt.errVal)
+			if err.Error() != "wrap: x 1" {
+				t.Errorf("expected=%v, got=%v", "wrap: x 1", err.Error())
+			}
+			for name, fn := range predicates {
+				if expect, actual := name == tt.predicate, fn(err); expect != actual {
+					t.Errorf("%s: expected=%v, got=%v", name, expect, actual)
+				}
+			}
+			if actual := CodeOf(err); actual != tt.code {
+				t.Errorf("CodeOf: expected=%v, got=%v", tt.code, actual)
+			}
+		})
+	}
+}
+
+func TestBehaviorConstructors_wrap(t *testing.T) {
+	err := NotFoundf("lookup: %w", testErr1)
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+	testExpectTrueHelper(t, IsNotFound(err), "IsNotFound(err)")
+}
+
+func TestBehaviorConstructors_wrapBehavior(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		is   func(error) bool
+	}{
+		{name: "conflict", err: NotFoundf("lookup: %w", Conflictf("test")), is: IsConflict},
+		{name: "already exists", err: Conflictf("create: %w", AlreadyExistsf("test")), is: IsAlreadyExists},
+		{name: "unauthorized", err: Forbiddenf("access: %w", Unauthorizedf("test")), is: IsUnauthorized},
+		{name: "forbidden", err: WithCode(Forbiddenf("test"), CodeInternal), is: IsForbidden},
+		{name: "invalid input", err: NotFoundf("parse: %w", InvalidInputf("test")), is: IsInvalidInput},
+		{name: "unavailable", err: InvalidInputf("call: %w", Unavailablef("test")), is: IsUnavailable},
+		{name: "custom", err: NotFoundf("lookup: %w", testConflictError{}), is: IsConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testExpectTrueHelper(t, tt.is(tt.err), "wrapped behavior")
+		})
+	}
+	testExpectTrueHelper(t, !IsConflict(NotFoundf("lookup: %v", Conflictf("test"))), "!IsConflict(not wrapped)")
+}
+
+func TestIsConflict(t *testing.T) {
+	testExpectTrueHelper(t, !IsConflict(nil), "!IsConflict(nil)")
+	testExpectTrueHelper(t, !IsConflict(testErr1), "!IsConflict(testErr1)")
+	testExpectTrueHelper(t, IsConflict(fmt.Errorf("a: %w", testConflictError{})), "IsConflict(custom)")
+	testExpectTrueHelper(t, CodeOf(testConflictError{}) == CodeConflict, "CodeOf(custom) == CodeConflict")
+}
@@ -0,0 +1,66 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"path/filepath"
+	"runtime"
+	"strconv"
+)
+
+// CallerKey is the field key under which Here records the caller.
+const CallerKey = "caller"
+
+// Caller identifies the location in the source code where Here was called.
+type Caller struct {
+	// Function is the fully qualified name of the calling function.
+	Function string
+	// File is the full path of the source file.
+	File string
+	// Line is the line number in File.
+	Line int
+}
+
+// String returns the caller as "function (file:line)", using the base name of the file.
+func (c Caller) String() string {
+	return c.Function + " (" + filepath.Base(c.File) + ":" + strconv.Itoa(c.Line) + ")"
+}
+
+// Here wraps err with the location of its immediate caller, stored as a Caller in the "caller" field.
+// It is a cheap alternative to capturing a full stack trace: the caller can be retrieved with Fields,
+// and formatting the error with %+v renders it after the message.
+// If err is nil, Here returns nil.
+//
+// Like other fields, when Here is used more than once in an error chain the outermost caller wins.
+func Here(err error) error {
+	if err == nil {
+		return nil
+	}
+	var c Caller
+	if pc, file, line, ok := runtime.Caller(1); ok {
+		c = Caller{File: file, Line: line}
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			c.Function = fn.Name()
+		}
+	}
+	return &fieldsError{err: err, fields: map[string]any{CallerKey: c}}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestHere(t *testing.T) {
+	testExpectTrueHelper(t, Here(nil) == nil, "Here(nil) == nil")
+	err := Here(testErr1)
+	testExpectTrueHelper(t, err.Error() == testErr1.Error(), "err.Error() == testErr1.Error()")
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+	c, ok := Fields(err)[CallerKey].(Caller)
+	if !ok {
+		t.Fatalf("expected Caller field, got %T", Fields(err)[CallerKey])
+	}
+	testExpectTrueHelper(t, strings.HasSuffix(c.Function, ".TestHere"), "c.Function ends with .TestHere")
+	testExpectTrueHelper(t, strings.HasSuffix(c.File, "caller_test.go"), "c.File ends with caller_test.go")
+	testExpectTrueHelper(t, c.Line > 0, "c.Line > 0")
+	formatted := fmt.Sprintf("%+v", err)
+	expect := fmt.Sprintf("%s caller=%s", testErr1, c)
+	if formatted != expect {
+		t.Errorf("expected=%v, got=%v", expect, formatted)
+	}
+}
+
+func TestCaller_String(t *testing.T) {
+	c := Caller{Function: "example.com/pkg.Func", File: "/src/pkg/file.go", Line: 12}
+	if actual, expect := c.String(), "example.com/pkg.Func (file.go:12)"; actual != expect {
+		t.Errorf("expected=%v, got=%v", expect, actual)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"iter"
+)
+
+// Chain returns an iterator over err and every error it wraps.
+//
+// The errors are visited depth-first, in the same order used by errors.Is and errors.As:
+// an error is visited before the errors it wraps, and the branches of an error implementing
+// `Unwrap() []error` (such as one created by errors.Join) are visited in order.
+func Chain(err error) iter.Seq[error] {
+	return func(yield func(error) bool) {
+		walk(err, yield)
+	}
+}
+
+// Root returns the innermost error wrapped by err.
+// When err wraps multiple errors using `Unwrap() []error`, Root follows the first one.
+// If err does not wrap any errors, Root returns err.
+func Root(err error) error {
+	for {
+		var next error
+		if x, ok := err.(interface{ Unwrap() error }); ok { //nolint:errorlint // the chain is walked one error at a time.
+			next = x.Unwrap()
+		} else if x, ok := err.(interface{ Unwrap() []error }); ok { //nolint:errorlint // the chain is walked one error at a time.
+			if errs := x.Unwrap(); len(errs) > 0 {
+				next = errs[0]
+			}
+		}
+		if next == nil {
+			return err
+		}
+		err = next
+	}
+}
+
+// As finds the first error in err's chain that matches type T, and returns it.
+// It is a generic alternative to errors.As that doesn't require declaring a target variable.
+func As[T error](err error) (T, bool) {
+	var target T
+	ok := errors.As(err, &target)
+	return target, ok
+}
+
+// Has reports whether any error in err's chain matches type T.
+func Has[T error](err error) bool {
+	_, ok := As[T](err)
+	return ok
+}
+
+// walk calls fn for err and each error in its tree, depth-first, following both
+// `Unwrap() error` and `Unwrap() []error`. Walking stops when fn returns false.
+func walk(err error, fn func(err error) bool) bool {
+	if err == nil {
+		return true
+	}
+	if !fn(err) {
+		return false
+	}
+	if x, ok := err.(interface{ Unwrap() error }); ok { //nolint:errorlint // the tree is walked one error at a time.
+		return walk(x.Unwrap(), fn)
+	}
+	if x, ok := err.(interface{ Unwrap() []error }); ok { //nolint:errorlint // the tree is walked one error at a time.
+		for _, e := range x.Unwrap() {
+			if !walk(e, fn) {
+				return false
+			}
+		}
+	}
+	return true
+}
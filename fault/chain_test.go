@@ -0,0 +1,105 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleChain() {
+	err := fmt.Errorf("outer: %w", errors.Join(testErr1, fmt.Errorf("inner: %w", testErr2)))
+	for e := range Chain(err) {
+		fmt.Printf("%q\n", e.Error())
+	}
+	// Output:
+	// "outer: error: 1\ninner: error: 2"
+	// "error: 1\ninner: error: 2"
+	// "error: 1"
+	// "inner: error: 2"
+	// "error: 2"
+}
+
+func TestChain(t *testing.T) {
+	tests := []struct {
+		name   string
+		errVal error
+		expect []string
+	}{
+		{name: "nil", errVal: nil, expect: nil},
+		{name: "single", errVal: testErr1, expect: []string{"error: 1"}},
+		{name: "wrapped", errVal: fmt.Errorf("a: %w", testErr1), expect: []string{"a: error: 1", "error: 1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual []string
+			for e := range Chain(tt.errVal) {
+				actual = append(actual, e.Error())
+			}
+			if fmt.Sprint(actual) != fmt.Sprint(tt.expect) {
+				t.Errorf("expected=%q, got=%q", tt.expect, actual)
+			}
+		})
+	}
+	t.Run("break", func(t *testing.T) {
+		var count int
+		for range Chain(fmt.Errorf("a: %w", fmt.Errorf("b: %w", testErr1))) {
+			count++
+			break
+		}
+		testExpectTrueHelper(t, count == 1, "count == 1")
+	})
+}
+
+func TestRoot(t *testing.T) {
+	panicErr := &PanicError{Value: "test"}
+	tests := []struct {
+		name   string
+		errVal error
+		expect error
+	}{
+		{name: "nil", errVal: nil, expect: nil},
+		{name: "single", errVal: testErr1, expect: testErr1},
+		{name: "wrapped", errVal: fmt.Errorf("a: %w", fmt.Errorf("b: %w", testErr1)), expect: testErr1},
+		{name: "joined", errVal: fmt.Errorf("a: %w", errors.Join(testErr2, testErr1)), expect: testErr2},
+		{name: "unwrap-nil", errVal: panicErr, expect: panicErr},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := Root(tt.errVal)
+			if actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestHas(t *testing.T) {
+	err := fmt.Errorf("test: %w", errors.Join(testErr1, &testNotFoundError{Value: true}))
+	testExpectTrueHelper(t, Has[*testNotFoundError](err), "Has[*testNotFoundError](err)")
+	testExpectTrueHelper(t, Has[Message](err), "Has[Message](err)")
+	testExpectTrueHelper(t, !Has[*PanicError](err), "!Has[*PanicError](err)")
+	testExpectTrueHelper(t, !Has[*PanicError](nil), "!Has[*PanicError](nil)")
+	nf, ok := As[*testNotFoundError](err)
+	testExpectTrueHelper(t, ok && nf.Value, "As[*testNotFoundError](err)")
+}
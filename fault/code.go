@@ -0,0 +1,195 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"context"
+	"errors"
+)
+
+// Code classifies an error into a broad category, independent of the error message.
+// Codes are modeled after the gRPC canonical codes, and are stable strings so that they can be serialized.
+type Code string
+
+// These are the error codes understood by the fault package.
+const (
+	// CodeOK is the code of a nil error.
+	CodeOK Code = "ok"
+	// CodeUnknown is the code of an error that has not been classified.
+	CodeUnknown Code = "unknown"
+	// CodeCanceled means the operation was canceled, typically by the caller.
+	CodeCanceled Code = "canceled"
+	// CodeInvalidArgument means the caller specified an invalid argument.
+	CodeInvalidArgument Code = "invalid_argument"
+	// CodeDeadlineExceeded means the operation did not complete before its deadline.
+	CodeDeadlineExceeded Code = "deadline_exceeded"
+	// CodeNotFound means a requested entity was not found.
+	CodeNotFound Code = "not_found"
+	// CodeAlreadyExists means an entity that the caller attempted to create already exists.
+	CodeAlreadyExists Code = "already_exists"
+	// CodeConflict means the operation conflicts with the current state of the target, such as a concurrent modification.
+	CodeConflict Code = "conflict"
+	// CodePermissionDenied means the caller does not have permission to perform the operation.
+	CodePermissionDenied Code = "permission_denied"
+	// CodeUnauthenticated means the caller could not be authenticated.
+	CodeUnauthenticated Code = "unauthenticated"
+	// CodeResourceExhausted means some resource, such as a quota, has been exhausted.
+	CodeResourceExhausted Code = "resource_exhausted"
+	// CodeFailedPrecondition means the system is not in a state required for the operation.
+	CodeFailedPrecondition Code = "failed_precondition"
+	// CodeUnimplemented means the operation is not implemented or supported.
+	CodeUnimplemented Code = "unimplemented"
+	// CodeUnavailable means the service is currently unavailable; this is most likely transient.
+	CodeUnavailable Code = "unavailable"
+	// CodeInternal means an internal invariant was broken.
+	CodeInternal Code = "internal"
+)
+
+// String returns the code as a string.
+func (c Code) String() string {
+	return string(c)
+}
+
+// NewCoded creates a new error with the given code and message.
+func NewCoded(code Code, msg string) error {
+	return &codedError{code: code, err: Message(msg)}
+}
+
+// WithCode wraps err with the given code. The error message is unchanged.
+// If err is nil, WithCode returns nil.
+func WithCode(err error, code Code) error {
+	if err == nil {
+		return nil
+	}
+	return &codedError{code: code, err: err, wrapped: true}
+}
+
+// CodeOf returns the code of the error or the first of its wrapped errors that has a code.
+// An error has a code if it implements the function `Code() fault.Code`.
+//
+// If no code is found, errors are classified by their behavior: context cancellation and deadline errors,
+// and errors for which IsNotFound, IsConflict, IsAlreadyExists, IsUnauthorized, IsForbidden, IsInvalidInput
+// or IsUnavailable report true, are given the corresponding code.
+// Otherwise, CodeOf returns CodeUnknown. The code of a nil error is CodeOK.
+func CodeOf(err error) Code {
+	if err == nil {
+		return CodeOK
+	}
+	var asErr interface{ Code() Code }
+	if errors.As(err, &asErr) {
+		return asErr.Code()
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return CodeCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return CodeDeadlineExceeded
+	case IsNotFound(err):
+		return CodeNotFound
+	case IsConflict(err):
+		return CodeConflict
+	case IsAlreadyExists(err):
+		return CodeAlreadyExists
+	case IsUnauthorized(err):
+		return CodeUnauthenticated
+	case IsForbidden(err):
+		return CodePermissionDenied
+	case IsInvalidInput(err):
+		return CodeInvalidArgument
+	case IsUnavailable(err):
+		return CodeUnavailable
+	}
+	return CodeUnknown
+}
+
+type codedError struct {
+	code    Code
+	err     error
+	wrapped bool
+}
+
+func (e *codedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *codedError) Unwrap() error {
+	if e.wrapped {
+		return e.err
+	}
+	return nil
+}
+
+func (e *codedError) Code() Code {
+	return e.code
+}
+
+// wraps reports whether the error wrapped by e satisfies is.
+// errors.As stops at the first error implementing a behavior, so without this, a code which doesn't match
+// would hide the behaviors of the errors it wraps.
+func (e *codedError) wraps(is func(error) bool) bool {
+	return e.wrapped && is(e.err)
+}
+
+// NotFound makes IsNotFound report true for errors with CodeNotFound, or which wrap a NotFound error.
+func (e *codedError) NotFound() bool {
+	return e.code == CodeNotFound || e.wraps(IsNotFound)
+}
+
+// Timeout makes IsTimeout report true for errors with CodeDeadlineExceeded, or which wrap a Timeout error.
+func (e *codedError) Timeout() bool {
+	return e.code == CodeDeadlineExceeded || e.wraps(IsTimeout)
+}
+
+// Temporary makes IsTemporary report true for errors with CodeUnavailable, or which wrap a Temporary error.
+func (e *codedError) Temporary() bool {
+	return e.code == CodeUnavailable || e.wraps(IsTemporary)
+}
+
+// Conflict makes IsConflict report true for errors with CodeConflict, or which wrap a Conflict error.
+func (e *codedError) Conflict() bool {
+	return e.code == CodeConflict || e.wraps(IsConflict)
+}
+
+// AlreadyExists makes IsAlreadyExists report true for errors with CodeAlreadyExists, or which wrap an AlreadyExists error.
+func (e *codedError) AlreadyExists() bool {
+	return e.code == CodeAlreadyExists || e.wraps(IsAlreadyExists)
+}
+
+// Unauthorized makes IsUnauthorized report true for errors with CodeUnauthenticated, or which wrap an Unauthorized error.
+func (e *codedError) Unauthorized() bool {
+	return e.code == CodeUnauthenticated || e.wraps(IsUnauthorized)
+}
+
+// Forbidden makes IsForbidden report true for errors with CodePermissionDenied, or which wrap a Forbidden error.
+func (e *codedError) Forbidden() bool {
+	return e.code == CodePermissionDenied || e.wraps(IsForbidden)
+}
+
+// InvalidInput makes IsInvalidInput report true for errors with CodeInvalidArgument, or which wrap an InvalidInput error.
+func (e *codedError) InvalidInput() bool {
+	return e.code == CodeInvalidArgument || e.wraps(IsInvalidInput)
+}
+
+// Unavailable makes IsUnavailable report true for errors with CodeUnavailable, or which wrap an Unavailable error.
+func (e *codedError) Unavailable() bool {
+	return e.code == CodeUnavailable || e.wraps(IsUnavailable)
+}
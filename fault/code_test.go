@@ -0,0 +1,90 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleCodeOf() {
+	err := fmt.Errorf("get user: %w", NewCoded(CodeNotFound, "user does not exist"))
+	fmt.Println(err)
+	fmt.Println(CodeOf(err))
+	fmt.Println(IsNotFound(err))
+	// Output:
+	// get user: user does not exist
+	// not_found
+	// true
+}
+
+func TestCodeOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		errVal error
+		expect Code
+	}{
+		{name: "nil", errVal: nil, expect: CodeOK},
+		{name: "standard-error", errVal: errors.New("standard error"), expect: CodeUnknown},
+		{name: "coded", errVal: NewCoded(CodeConflict, "test"), expect: CodeConflict},
+		{name: "wrapped-coded", errVal: fmt.Errorf("test: %w", NewCoded(CodeInternal, "test")), expect: CodeInternal},
+		{name: "with-code", errVal: WithCode(testErr1, CodeUnavailable), expect: CodeUnavailable},
+		{name: "outer-code-wins", errVal: WithCode(NewCoded(CodeInternal, "test"), CodeNotFound), expect: CodeNotFound},
+		{name: "context-canceled", errVal: fmt.Errorf("test: %w", context.Canceled), expect: CodeCanceled},
+		{name: "context-deadline", errVal: context.DeadlineExceeded, expect: CodeDeadlineExceeded},
+		{name: "not-found-behavior", errVal: &testNotFoundError{Value: true}, expect: CodeNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := CodeOf(tt.errVal)
+			if tt.expect != actual {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestWithCode(t *testing.T) {
+	testExpectTrueHelper(t, WithCode(nil, CodeInternal) == nil, "WithCode(nil) == nil")
+	err := WithCode(testErr1, CodeInternal)
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+	testExpectTrueHelper(t, err.Error() == testErr1.Error(), "err.Error() == testErr1.Error()")
+	testExpectTrueHelper(t, errors.Unwrap(NewCoded(CodeInternal, "test")) == nil, "errors.Unwrap(NewCoded()) == nil")
+}
+
+func TestCode_behaviors(t *testing.T) {
+	testExpectTrueHelper(t, IsNotFound(NewCoded(CodeNotFound, "test")), "IsNotFound(CodeNotFound)")
+	testExpectTrueHelper(t, !IsNotFound(NewCoded(CodeInternal, "test")), "!IsNotFound(CodeInternal)")
+	testExpectTrueHelper(t, IsTimeout(NewCoded(CodeDeadlineExceeded, "test")), "IsTimeout(CodeDeadlineExceeded)")
+	testExpectTrueHelper(t, IsTemporary(NewCoded(CodeUnavailable, "test")), "IsTemporary(CodeUnavailable)")
+}
+
+func TestCode_behaviors_wrapped(t *testing.T) {
+	timeoutErr := MarkTimeout(errors.New("i/o timeout"))
+	testExpectTrueHelper(t, IsTimeout(WithCode(timeoutErr, CodeInternal)), "IsTimeout(WithCode(timeout, CodeInternal))")
+	testExpectTrueHelper(t, IsTemporary(WithCode(MarkTemporary(errors.New("test")), CodeInternal)), "IsTemporary(WithCode(temporary, CodeInternal))")
+	testExpectTrueHelper(t, IsNotFound(WithCode(NewCoded(CodeNotFound, "test"), CodeInternal)), "IsNotFound(WithCode(notFound, CodeInternal))")
+	testExpectTrueHelper(t, !IsTimeout(WithCode(errors.New("test"), CodeInternal)), "!IsTimeout(WithCode(err, CodeInternal))")
+	testExpectTrueHelper(t, CodeOf(WithCode(timeoutErr, CodeInternal)) == CodeInternal, "CodeOf(WithCode(timeout, CodeInternal))")
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"encoding/json"
+)
+
+var nullBytes = []byte(`null`)
+
+// Envelope is a serializable representation of an error, which can cross process boundaries
+// such as HTTP APIs and job queues. Use Encode and Decode to convert errors to and from JSON.
+//
+// The concrete error types are not preserved, but the message, classification and fields are;
+// so CodeOf, IsRetryable and Fields give the same results for the decoded error as for the original.
+type Envelope struct {
+	// Code is the code of the error, as reported by CodeOf.
+	Code Code `json:"code"`
+	// Message is the error message.
+	Message string `json:"message"`
+	// Retryable reports whether the error is retryable, as reported by IsRetryable.
+	Retryable bool `json:"retryable,omitempty"`
+	// Fields are the structured fields of the error, as reported by Fields.
+	Fields map[string]any `json:"fields,omitempty"`
+	// Chain contains the distinct messages of the errors wrapped by the error, outermost first.
+	Chain []string `json:"chain,omitempty"`
+}
+
+// ToEnvelope creates an Envelope describing err.
+func ToEnvelope(err error) Envelope {
+	env := Envelope{
+		Code:      CodeOf(err),
+		Retryable: IsRetryable(err),
+		Fields:    Fields(err),
+	}
+	if err == nil {
+		return env
+	}
+	env.Message = err.Error()
+	last := env.Message
+	for e := range Chain(err) {
+		// skip wrappers that only annotate the error without changing its message.
+		if msg := e.Error(); msg != last {
+			env.Chain = append(env.Chain, msg)
+			last = msg
+		}
+	}
+	return env
+}
+
+// Err reconstructs an error from the Envelope.
+// The error message of the reconstructed error, and each error in its chain, matches the original.
+// If the Envelope describes a nil error, including the zero Envelope, Err returns nil.
+func (env Envelope) Err() error {
+	if (env.Code == "" || env.Code == CodeOK) && env.Message == "" {
+		return nil
+	}
+	var inner error
+	for i := len(env.Chain) - 1; i >= 0; i-- {
+		inner = &remoteError{msg: env.Chain[i], next: inner}
+	}
+	var err error = &remoteError{msg: env.Message, next: inner}
+	if len(env.Fields) > 0 {
+		err = &fieldsError{err: err, fields: env.Fields}
+	}
+	if env.Retryable {
+		err = MarkRetryable(err)
+	}
+	return WithCode(err, env.Code)
+}
+
+// Encode serializes err to JSON as an Envelope.
+// A nil error is encoded as JSON 'null'.
+func Encode(err error) ([]byte, error) {
+	if err == nil {
+		return nullBytes, nil
+	}
+	return json.Marshal(ToEnvelope(err))
+}
+
+// Decode parses an Envelope that was serialized using Encode.
+// The error returned by Decode reports whether data is a valid Envelope;
+// use Envelope.Err to reconstruct the encoded error.
+// JSON 'null' decodes to the zero Envelope, which describes a nil error.
+func Decode(data []byte) (Envelope, error) {
+	var env Envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return Envelope{}, err
+	}
+	return env, nil
+}
+
+// remoteError is an error reconstructed from an Envelope.
+type remoteError struct {
+	msg  string
+	next error
+}
+
+func (e *remoteError) Error() string {
+	return e.msg
+}
+
+func (e *remoteError) Unwrap() error {
+	return e.next
+}
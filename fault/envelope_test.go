@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func ExampleEncode() {
+	err := fmt.Errorf("get user: %w", With(NewCoded(CodeNotFound, "no such user"), "user", "alice"))
+	data, _ := Encode(err)
+	fmt.Println(string(data))
+	env, _ := Decode(data)
+	decoded := env.Err()
+	fmt.Println(decoded)
+	fmt.Println(IsNotFound(decoded), Fields(decoded))
+	// Output:
+	// {"code":"not_found","message":"get user: no such user","fields":{"user":"alice"},"chain":["no such user"]}
+	// get user: no such user
+	// true map[user:alice]
+}
+
+func TestEncode_roundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		errVal error
+	}{
+		{name: "nil", errVal: nil},
+		{name: "standard-error", errVal: errors.New("test")},
+		{name: "coded", errVal: NewCoded(CodeConflict, "test")},
+		{name: "retryable", errVal: MarkRetryable(WithCode(testErr1, CodeUnavailable))},
+		{name: "fields", errVal: With(fmt.Errorf("wrap: %w", testErr1), "a", "b")},
+		{name: "joined", errVal: errors.Join(testErr1, testErr2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Encode(tt.errVal)
+			if err != nil {
+				t.Fatalf("unexpected encode error: %v", err)
+			}
+			env, err := Decode(data)
+			if err != nil {
+				t.Fatalf("unexpected decode error: %v", err)
+			}
+			decoded := env.Err()
+			if tt.errVal == nil {
+				if decoded != nil {
+					t.Fatalf("expected nil error, got %v", decoded)
+				}
+				return
+			}
+			if decoded.Error() != tt.errVal.Error() {
+				t.Errorf("Error() expected=%q, got=%q", tt.errVal.Error(), decoded.Error())
+			}
+			if CodeOf(decoded) != CodeOf(tt.errVal) {
+				t.Errorf("CodeOf() expected=%v, got=%v", CodeOf(tt.errVal), CodeOf(decoded))
+			}
+			if IsRetryable(decoded) != IsRetryable(tt.errVal) {
+				t.Errorf("IsRetryable() expected=%t, got=%t", IsRetryable(tt.errVal), IsRetryable(decoded))
+			}
+			if !reflect.DeepEqual(Fields(decoded), Fields(tt.errVal)) {
+				t.Errorf("Fields() expected=%v, got=%v", Fields(tt.errVal), Fields(decoded))
+			}
+			if !reflect.DeepEqual(ToEnvelope(decoded).Chain, ToEnvelope(tt.errVal).Chain) {
+				t.Errorf("Chain expected=%v, got=%v", ToEnvelope(tt.errVal).Chain, ToEnvelope(decoded).Chain)
+			}
+		})
+	}
+}
+
+func TestDecode_null(t *testing.T) {
+	env, err := Decode([]byte(` null `))
+	if err != nil {
+		t.Fatalf("unexpected decode error: %v", err)
+	}
+	if !reflect.DeepEqual(env, Envelope{}) {
+		t.Errorf("expected=%v, got=%v", Envelope{}, env)
+	}
+	if err = env.Err(); err != nil {
+		t.Errorf("expected nil error, got %v", err)
+	}
+}
+
+func TestDecode_error(t *testing.T) {
+	if _, err := Decode([]byte(`{"code":`)); err == nil {
+		t.Fatal("expected json unmarshal error")
+	}
+}
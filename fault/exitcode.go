@@ -0,0 +1,71 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import "errors"
+
+// WithExitCode wraps err with a process exit status, which is returned by ExitCode.
+// The error message is unchanged. If err is nil, WithExitCode returns nil.
+func WithExitCode(err error, code int) error {
+	if err == nil {
+		return nil
+	}
+	return &exitCodeError{err: err, code: code}
+}
+
+// ExitCode returns the exit status of the error or the first of its wrapped errors that has one,
+// so that command-line tools can map errors to exit statuses uniformly:
+//
+//	if err := run(); err != nil {
+//		fmt.Fprintln(os.Stderr, err)
+//		os.Exit(fault.ExitCode(err))
+//	}
+//
+// An error has an exit status if it implements the function `ExitCode() int`, such as *exec.ExitError.
+// The exit status of a nil error is 0. If no exit status is found, ExitCode returns 1.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var asErr interface{ ExitCode() int }
+	if errors.As(err, &asErr) {
+		return asErr.ExitCode()
+	}
+	return 1
+}
+
+type exitCodeError struct {
+	err  error
+	code int
+}
+
+func (e *exitCodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *exitCodeError) Unwrap() error {
+	return e.err
+}
+
+func (e *exitCodeError) ExitCode() int {
+	return e.code
+}
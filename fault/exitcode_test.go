@@ -0,0 +1,67 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleExitCode() {
+	err := WithExitCode(errors.New("config file not found"), 78)
+	err = fmt.Errorf("startup: %w", err)
+	fmt.Println(err)
+	fmt.Println(ExitCode(err))
+	// Output:
+	// startup: config file not found
+	// 78
+}
+
+func TestExitCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		errVal error
+		expect int
+	}{
+		{name: "nil", errVal: nil, expect: 0},
+		{name: "no-code", errVal: testErr1, expect: 1},
+		{name: "code", errVal: WithExitCode(testErr1, 2), expect: 2},
+		{name: "zero-code", errVal: WithExitCode(testErr1, 0), expect: 0},
+		{name: "outer-wins", errVal: WithExitCode(fmt.Errorf("a: %w", WithExitCode(testErr1, 3)), 4), expect: 4},
+		{name: "wrapped", errVal: fmt.Errorf("a: %w", WithExitCode(testErr1, 3)), expect: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := ExitCode(tt.errVal); actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestWithExitCode(t *testing.T) {
+	testExpectTrueHelper(t, WithExitCode(nil, 2) == nil, "WithExitCode(nil) == nil")
+	err := WithExitCode(testErr1, 2)
+	testExpectTrueHelper(t, err.Error() == testErr1.Error(), "err.Error() == testErr1.Error()")
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// badKey is the key used for a value in With that is missing its key.
+const badKey = "!BADKEY"
+
+// With wraps err with structured key/value fields, which can be retrieved with Fields.
+// The error message is unchanged, but formatting the error with %+v renders the fields after the message.
+// If err is nil, With returns nil.
+//
+// The arguments are alternating keys and values, in the same style as log/slog:
+//
+//	fault.With(err, "request_id", reqID, "bucket", bucket)
+//
+// Keys that are not strings are formatted with fmt.Sprint. A trailing value without a key is stored under "!BADKEY".
+func With(err error, keyvals ...any) error {
+	if err == nil {
+		return nil
+	}
+	fields := make(map[string]any, (len(keyvals)+1)/2)
+	for i := 0; i < len(keyvals); i += 2 {
+		if i+1 == len(keyvals) {
+			fields[badKey] = keyvals[i]
+			break
+		}
+		key, ok := keyvals[i].(string)
+		if !ok {
+			key = fmt.Sprint(keyvals[i])
+		}
+		fields[key] = keyvals[i+1]
+	}
+	return &fieldsError{err: err, fields: fields}
+}
+
+// Fields returns the structured fields attached to err and any of its wrapped errors using With.
+// When the same key is attached more than once, the outermost value wins.
+// If there are no fields, Fields returns nil.
+func Fields(err error) map[string]any {
+	var result map[string]any
+	for e := range Chain(err) {
+		fe, ok := e.(*fieldsError)
+		if !ok {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]any, len(fe.fields))
+		}
+		for k, v := range fe.fields {
+			if _, exists := result[k]; !exists {
+				result[k] = v
+			}
+		}
+	}
+	return result
+}
+
+type fieldsError struct {
+	err    error
+	fields map[string]any
+}
+
+func (e *fieldsError) Error() string {
+	return e.err.Error()
+}
+
+func (e *fieldsError) Unwrap() error {
+	return e.err
+}
+
+// Format implements fmt.Formatter. The %+v verb renders the error message followed by its fields,
+// sorted by key; Secret values render as RedactedPlaceholder. Other verbs render the error message.
+func (e *fieldsError) Format(f fmt.State, verb rune) {
+	if verb != 'v' || !f.Flag('+') {
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), e.Error())
+		return
+	}
+	_, _ = io.WriteString(f, e.Error())
+	fields := Fields(e)
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(f, " %s=%v", k, fields[k])
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func ExampleWith() {
+	err := With(testErr1, "request_id", "abc123", "attempt", 2)
+	err = fmt.Errorf("handle request: %w", err)
+	fmt.Println(err)
+	fmt.Println(Fields(err))
+	// Output:
+	// handle request: error: 1
+	// map[attempt:2 request_id:abc123]
+}
+
+func TestWith(t *testing.T) {
+	testExpectTrueHelper(t, With(nil, "key", "value") == nil, "With(nil) == nil")
+	err := With(testErr1, "key", "value")
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+	testExpectTrueHelper(t, err.Error() == testErr1.Error(), "err.Error() == testErr1.Error()")
+}
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		name   string
+		errVal error
+		expect map[string]any
+	}{
+		{
+			name:   "nil",
+			errVal: nil,
+			expect: nil,
+		},
+		{
+			name:   "no-fields",
+			errVal: testErr1,
+			expect: nil,
+		},
+		{
+			name:   "fields",
+			errVal: With(testErr1, "a", 1, "b", "two"),
+			expect: map[string]any{"a": 1, "b": "two"},
+		},
+		{
+			name:   "bad-key",
+			errVal: With(testErr1, 1, "one", "dangling"),
+			expect: map[string]any{"1": "one", "!BADKEY": "dangling"},
+		},
+		{
+			name:   "merged-outer-wins",
+			errVal: With(fmt.Errorf("wrap: %w", With(testErr1, "a", 1, "b", 2)), "a", 3),
+			expect: map[string]any{"a": 3, "b": 2},
+		},
+		{
+			name:   "joined",
+			errVal: errors.Join(With(testErr1, "a", 1), With(testErr2, "b", 2)),
+			expect: map[string]any{"a": 1, "b": 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := Fields(tt.errVal)
+			if !reflect.DeepEqual(tt.expect, actual) {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import "errors"
+
+// GRPCCode is a gRPC canonical status code.
+// It has the same numeric values as codes.Code from google.golang.org/grpc/codes,
+// so values can be converted between the two types directly:
+//
+//	st := status.New(codes.Code(fault.ToGRPCCode(fault.CodeOf(err))), msg)
+//
+// This lets gRPC services use fault without this package depending on gRPC.
+type GRPCCode uint32
+
+// These are the gRPC canonical status codes.
+const (
+	GRPCOK                 GRPCCode = 0
+	GRPCCanceled           GRPCCode = 1
+	GRPCUnknown            GRPCCode = 2
+	GRPCInvalidArgument    GRPCCode = 3
+	GRPCDeadlineExceeded   GRPCCode = 4
+	GRPCNotFound           GRPCCode = 5
+	GRPCAlreadyExists      GRPCCode = 6
+	GRPCPermissionDenied   GRPCCode = 7
+	GRPCResourceExhausted  GRPCCode = 8
+	GRPCFailedPrecondition GRPCCode = 9
+	GRPCAborted            GRPCCode = 10
+	GRPCOutOfRange         GRPCCode = 11
+	GRPCUnimplemented      GRPCCode = 12
+	GRPCInternal           GRPCCode = 13
+	GRPCUnavailable        GRPCCode = 14
+	GRPCDataLoss           GRPCCode = 15
+	GRPCUnauthenticated    GRPCCode = 16
+)
+
+var codeToGRPC = map[Code]GRPCCode{
+	CodeOK:                 GRPCOK,
+	CodeUnknown:            GRPCUnknown,
+	CodeCanceled:           GRPCCanceled,
+	CodeInvalidArgument:    GRPCInvalidArgument,
+	CodeDeadlineExceeded:   GRPCDeadlineExceeded,
+	CodeNotFound:           GRPCNotFound,
+	CodeAlreadyExists:      GRPCAlreadyExists,
+	CodeConflict:           GRPCAborted,
+	CodePermissionDenied:   GRPCPermissionDenied,
+	CodeUnauthenticated:    GRPCUnauthenticated,
+	CodeResourceExhausted:  GRPCResourceExhausted,
+	CodeFailedPrecondition: GRPCFailedPrecondition,
+	CodeUnimplemented:      GRPCUnimplemented,
+	CodeUnavailable:        GRPCUnavailable,
+	CodeInternal:           GRPCInternal,
+}
+
+var grpcToCode = map[GRPCCode]Code{
+	GRPCOK:                 CodeOK,
+	GRPCCanceled:           CodeCanceled,
+	GRPCUnknown:            CodeUnknown,
+	GRPCInvalidArgument:    CodeInvalidArgument,
+	GRPCDeadlineExceeded:   CodeDeadlineExceeded,
+	GRPCNotFound:           CodeNotFound,
+	GRPCAlreadyExists:      CodeAlreadyExists,
+	GRPCPermissionDenied:   CodePermissionDenied,
+	GRPCResourceExhausted:  CodeResourceExhausted,
+	GRPCFailedPrecondition: CodeFailedPrecondition,
+	GRPCAborted:            CodeConflict,
+	GRPCOutOfRange:         CodeInvalidArgument,
+	GRPCUnimplemented:      CodeUnimplemented,
+	GRPCInternal:           CodeInternal,
+	GRPCUnavailable:        CodeUnavailable,
+	GRPCDataLoss:           CodeInternal,
+	GRPCUnauthenticated:    CodeUnauthenticated,
+}
+
+// ToGRPCCode returns the gRPC canonical code corresponding to c.
+// CodeConflict maps to Aborted, and codes without a gRPC equivalent map to Unknown.
+func ToGRPCCode(c Code) GRPCCode {
+	if gc, ok := codeToGRPC[c]; ok {
+		return gc
+	}
+	return GRPCUnknown
+}
+
+// FromGRPCCode returns the Code corresponding to the gRPC canonical code gc.
+// Aborted maps to CodeConflict, OutOfRange maps to CodeInvalidArgument, DataLoss maps to CodeInternal,
+// and unrecognized codes map to CodeUnknown.
+func FromGRPCCode(gc GRPCCode) Code {
+	if c, ok := grpcToCode[gc]; ok {
+		return c
+	}
+	return CodeUnknown
+}
+
+// GRPCCodeOf returns the gRPC canonical code for err.
+//
+// If err or any of its wrapped errors implements the function `GRPCCode() fault.GRPCCode`, that code is returned.
+// Otherwise, the code is derived from CodeOf.
+func GRPCCodeOf(err error) GRPCCode {
+	var asErr interface{ GRPCCode() GRPCCode }
+	if errors.As(err, &asErr) {
+		return asErr.GRPCCode()
+	}
+	return ToGRPCCode(CodeOf(err))
+}
+
+// FromGRPC wraps err, which was received from a gRPC call with the code gc, so that CodeOf reports the
+// corresponding Code and GRPCCodeOf reports gc. If err is nil, FromGRPC returns nil.
+//
+//	err = fault.FromGRPC(err, fault.GRPCCode(status.Code(err)))
+func FromGRPC(err error, gc GRPCCode) error {
+	if err == nil {
+		return nil
+	}
+	return &grpcError{codedError: codedError{code: FromGRPCCode(gc), err: err, wrapped: true}, grpcCode: gc}
+}
+
+type grpcError struct {
+	codedError
+	grpcCode GRPCCode
+}
+
+func (e *grpcError) GRPCCode() GRPCCode {
+	return e.grpcCode
+}
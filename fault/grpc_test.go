@@ -0,0 +1,107 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestToGRPCCode(t *testing.T) {
+	tests := []struct {
+		code   Code
+		expect GRPCCode
+	}{
+		{code: CodeOK, expect: GRPCOK},
+		{code: CodeNotFound, expect: GRPCNotFound},
+		{code: CodeConflict, expect: GRPCAborted},
+		{code: CodeUnauthenticated, expect: GRPCUnauthenticated},
+		{code: Code("custom"), expect: GRPCUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code.String(), func(t *testing.T) {
+			if actual := ToGRPCCode(tt.code); actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestFromGRPCCode(t *testing.T) {
+	tests := []struct {
+		code   GRPCCode
+		expect Code
+	}{
+		{code: GRPCOK, expect: CodeOK},
+		{code: GRPCNotFound, expect: CodeNotFound},
+		{code: GRPCAborted, expect: CodeConflict},
+		{code: GRPCOutOfRange, expect: CodeInvalidArgument},
+		{code: GRPCDataLoss, expect: CodeInternal},
+		{code: GRPCCode(100), expect: CodeUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.code), func(t *testing.T) {
+			if actual := FromGRPCCode(tt.code); actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestGRPCCode_roundTrip(t *testing.T) {
+	for c := range codeToGRPC {
+		if actual := FromGRPCCode(ToGRPCCode(c)); actual != c {
+			t.Errorf("FromGRPCCode(ToGRPCCode(%v)) = %v", c, actual)
+		}
+	}
+}
+
+func TestGRPCCodeOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		errVal error
+		expect GRPCCode
+	}{
+		{name: "nil", errVal: nil, expect: GRPCOK},
+		{name: "standard-error", errVal: errors.New("test"), expect: GRPCUnknown},
+		{name: "coded", errVal: NewCoded(CodePermissionDenied, "test"), expect: GRPCPermissionDenied},
+		{name: "context-deadline", errVal: context.DeadlineExceeded, expect: GRPCDeadlineExceeded},
+		{name: "from-grpc", errVal: fmt.Errorf("test: %w", FromGRPC(testErr1, GRPCOutOfRange)), expect: GRPCOutOfRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := GRPCCodeOf(tt.errVal); actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestFromGRPC(t *testing.T) {
+	testExpectTrueHelper(t, FromGRPC(nil, GRPCInternal) == nil, "FromGRPC(nil) == nil")
+	err := FromGRPC(testErr1, GRPCNotFound)
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+	testExpectTrueHelper(t, CodeOf(err) == CodeNotFound, "CodeOf(err) == CodeNotFound")
+	testExpectTrueHelper(t, IsNotFound(err), "IsNotFound(err)")
+}
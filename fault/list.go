@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"strconv"
+	"strings"
+)
+
+// List accumulates multiple errors, such as the failures of a validation or batch operation.
+// The zero value is an empty List ready to use.
+//
+//	var errs fault.List
+//	for _, item := range items {
+//	    errs.Append(validate(item))
+//	}
+//	return errs.Err()
+//
+// A List is an error itself, and supports errors.Is and errors.As through `Unwrap() []error`.
+type List struct {
+	errs []error
+}
+
+// Append adds the given errors to the List. Nil errors are ignored,
+// and the errors contained in another List are added individually.
+func (l *List) Append(errs ...error) {
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		// only a List itself is flattened: a List wrapped by another error is kept whole, with its wrapper's message.
+		if list, ok := err.(*List); ok { //nolint:errorlint // the error itself is inspected, not its chain.
+			l.errs = append(l.errs, list.errs...)
+			continue
+		}
+		l.errs = append(l.errs, err)
+	}
+}
+
+// Len returns the number of errors in the List.
+func (l *List) Len() int {
+	return len(l.errs)
+}
+
+// Err returns nil if the List is empty, otherwise it returns a copy of the List as an error.
+// Errors appended to the List after calling Err do not affect the returned error.
+func (l *List) Err() error {
+	if len(l.errs) == 0 {
+		return nil
+	}
+	errs := make([]error, len(l.errs))
+	copy(errs, l.errs)
+	return &List{errs: errs}
+}
+
+// Error returns a summary of all the errors in the List.
+// A List with a single error returns that error's message.
+func (l *List) Error() string {
+	switch len(l.errs) {
+	case 0:
+		return "no errors"
+	case 1:
+		return l.errs[0].Error()
+	}
+	var sb strings.Builder
+	sb.WriteString(strconv.Itoa(len(l.errs)))
+	sb.WriteString(" errors occurred:")
+	for _, err := range l.errs {
+		sb.WriteString("\n\t* ")
+		// indent continuation lines so that nested lists remain readable.
+		sb.WriteString(strings.ReplaceAll(err.Error(), "\n", "\n\t  "))
+	}
+	return sb.String()
+}
+
+// Unwrap returns the errors in the List.
+func (l *List) Unwrap() []error {
+	return l.errs
+}
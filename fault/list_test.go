@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleList() {
+	var errs List
+	errs.Append(nil, testErr1)
+	errs.Append(fmt.Errorf("wrapped: %w", testErr2))
+	err := errs.Err()
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, testErr2))
+	// Output:
+	// 2 errors occurred:
+	// 	* error: 1
+	// 	* wrapped: error: 2
+	// true
+}
+
+func TestList_Err(t *testing.T) {
+	var errs List
+	errs.Append(nil, nil)
+	if err := errs.Err(); err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	errs.Append(testErr1)
+	err := errs.Err()
+	if err == nil || err.Error() != testErr1.Error() {
+		t.Fatalf("expected=%v, got=%v", testErr1, err)
+	}
+	errs.Append(testErr2)
+	testExpectTrueHelper(t, !errors.Is(err, testErr2), "!errors.Is(err, testErr2)")
+	testExpectTrueHelper(t, errs.Len() == 2, "errs.Len() == 2")
+}
+
+func TestList_Append(t *testing.T) {
+	var inner List
+	inner.Append(testErr1, testErr2)
+	var outer List
+	outer.Append(&inner, testErr1)
+	testExpectTrueHelper(t, outer.Len() == 3, "outer.Len() == 3")
+	var target *testNotFoundError
+	outer.Append(fmt.Errorf("wrapped: %w", &testNotFoundError{Value: true}))
+	testExpectTrueHelper(t, errors.As(outer.Err(), &target), "errors.As(outer.Err(), &target)")
+}
+
+func TestList_Error(t *testing.T) {
+	var errs List
+	testExpectTrueHelper(t, errs.Error() == "no errors", `errs.Error() == "no errors"`)
+	errs.Append(errors.Join(testErr1, testErr2), testErr1)
+	expect := "2 errors occurred:\n\t* error: 1\n\t  error: 2\n\t* error: 1"
+	if errs.Error() != expect {
+		t.Errorf("expected=%q, got=%q", expect, errs.Error())
+	}
+}
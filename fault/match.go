@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import "errors"
+
+// Any returns a matcher that reports true if any of the given matchers report true for an error.
+// With no matchers, it never matches.
+//
+// Matchers are plain `func(error) bool` values, so they can be used directly as attempt.RetryStrategy.ShouldRetry:
+//
+//	rs.ShouldRetry = fault.Any(fault.IsTemporary, fault.MatchCode(fault.CodeUnavailable))
+func Any(matchers ...func(error) bool) func(error) bool {
+	return func(err error) bool {
+		for _, m := range matchers {
+			if m(err) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// All returns a matcher that reports true if all the given matchers report true for an error.
+// With no matchers, it always matches.
+func All(matchers ...func(error) bool) func(error) bool {
+	return func(err error) bool {
+		for _, m := range matchers {
+			if !m(err) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// IsOneOf returns a matcher that reports true if errors.Is(err, target) is true for any of the targets.
+func IsOneOf(targets ...error) func(error) bool {
+	return func(err error) bool {
+		for _, target := range targets {
+			if errors.Is(err, target) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// MatchCode returns a matcher that reports true if CodeOf(err) is any of the given codes.
+func MatchCode(codes ...Code) func(error) bool {
+	return func(err error) bool {
+		code := CodeOf(err)
+		for _, c := range codes {
+			if c == code {
+				return true
+			}
+		}
+		return false
+	}
+}
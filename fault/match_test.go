@@ -0,0 +1,70 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleAny() {
+	shouldRetry := Any(IsTemporary, MatchCode(CodeUnavailable, CodeResourceExhausted))
+	fmt.Println(shouldRetry(MarkTemporary(testErr1)))
+	fmt.Println(shouldRetry(WithCode(testErr1, CodeResourceExhausted)))
+	fmt.Println(shouldRetry(WithCode(testErr1, CodeInvalidArgument)))
+	// Output:
+	// true
+	// true
+	// false
+}
+
+func TestMatchers(t *testing.T) {
+	always := func(error) bool { return true }
+	never := func(error) bool { return false }
+	tests := []struct {
+		name    string
+		matcher func(error) bool
+		errVal  error
+		expect  bool
+	}{
+		{name: "any-empty", matcher: Any(), errVal: testErr1, expect: false},
+		{name: "any-true", matcher: Any(never, always), errVal: testErr1, expect: true},
+		{name: "any-false", matcher: Any(never, never), errVal: testErr1, expect: false},
+		{name: "all-empty", matcher: All(), errVal: testErr1, expect: true},
+		{name: "all-true", matcher: All(always, always), errVal: testErr1, expect: true},
+		{name: "all-false", matcher: All(always, never), errVal: testErr1, expect: false},
+		{name: "is-one-of-true", matcher: IsOneOf(testErr2, testErr1), errVal: fmt.Errorf("a: %w", testErr1), expect: true},
+		{name: "is-one-of-false", matcher: IsOneOf(testErr2), errVal: testErr1, expect: false},
+		{name: "is-one-of-nil", matcher: IsOneOf(testErr1), errVal: nil, expect: false},
+		{name: "match-code-true", matcher: MatchCode(CodeNotFound), errVal: NotFoundf("x"), expect: true},
+		{name: "match-code-false", matcher: MatchCode(CodeNotFound), errVal: Conflictf("x"), expect: false},
+		{name: "match-code-ok", matcher: MatchCode(CodeOK), errVal: nil, expect: true},
+		{name: "nested", matcher: All(IsNotFound, Any(IsOneOf(testErr1), IsTemporary)), errVal: NotFoundf("x: %w", testErr1), expect: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := tt.matcher(tt.errVal); actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import "runtime/debug"
+
+// MustError is the value passed to panic by Must and its variants.
+type MustError struct {
+	// Err is the error that caused the panic.
+	Err error
+	// Stack is the stack trace of the goroutine that called Must.
+	Stack []byte
+}
+
+func (e *MustError) Error() string {
+	return "must: " + e.Err.Error()
+}
+
+func (e *MustError) Unwrap() error {
+	return e.Err
+}
+
+// Must returns t if err is nil, otherwise it panics with a *MustError wrapping err.
+// It is intended for initialization code and tests, where an error cannot be handled:
+//
+//	var tmpl = fault.Must(template.New("x").Parse(text))
+func Must[T any](t T, err error) T {
+	if err != nil {
+		panic(&MustError{Err: err, Stack: debug.Stack()})
+	}
+	return t
+}
+
+// Must2 is like Must, for functions that return two values and an error.
+func Must2[A, B any](a A, b B, err error) (A, B) {
+	if err != nil {
+		panic(&MustError{Err: err, Stack: debug.Stack()})
+	}
+	return a, b
+}
+
+// Must3 is like Must, for functions that return three values and an error.
+func Must3[A, B, C any](a A, b B, c C, err error) (A, B, C) {
+	if err != nil {
+		panic(&MustError{Err: err, Stack: debug.Stack()})
+	}
+	return a, b, c
+}
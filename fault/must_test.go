@@ -0,0 +1,71 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestMust(t *testing.T) {
+	if v := Must(strconv.Atoi("123")); v != 123 {
+		t.Errorf("Must() = %v, want 123", v)
+	}
+	err := Catch(func() error {
+		Must(strconv.Atoi("abc"))
+		return nil
+	})
+	var me *MustError
+	if !errors.As(err, &me) {
+		t.Fatalf("expected *MustError, got %T", err)
+	}
+	var numErr *strconv.NumError
+	testExpectTrueHelper(t, errors.As(err, &numErr), "errors.As(err, &numErr)")
+	testExpectTrueHelper(t, bytes.Contains(me.Stack, []byte("TestMust")), "stack contains caller")
+}
+
+func TestMust2(t *testing.T) {
+	a, b := Must2(1, "two", nil)
+	if a != 1 || b != "two" {
+		t.Errorf("Must2() = (%v,%v), want (1,two)", a, b)
+	}
+	err := Catch(func() error {
+		Must2(1, "two", testErr1)
+		return nil
+	})
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+}
+
+func TestMust3(t *testing.T) {
+	a, b, c := Must3(1, "two", 3.0, nil)
+	if a != 1 || b != "two" || c != 3.0 {
+		t.Errorf("Must3() = (%v,%v,%v), want (1,two,3)", a, b, c)
+	}
+	err := Catch(func() error {
+		Must3(1, "two", 3.0, testErr1)
+		return nil
+	})
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+	testExpectTrueHelper(t, err.Error() == "panic: must: error: 1", `err.Error() == "panic: must: error: 1"`)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExampleCatch() {
+	err := Catch(func() error {
+		var m map[string]int
+		m["boom"] = 1
+		return nil
+	})
+	fmt.Println(err)
+	// Output:
+	// panic: assignment to entry in nil map
+}
+
+func TestRecover(t *testing.T) {
+	fn := func(panicValue any, retErr error) (err error) {
+		defer Recover(&err)
+		if panicValue != nil {
+			panic(panicValue)
+		}
+		return retErr
+	}
+	if err := fn(nil, nil); err != nil {
+		t.Errorf("expected nil error, got %v", err)
+	}
+	if err := fn(nil, testErr1); !errors.Is(err, testErr1) {
+		t.Errorf("expected=%v, got=%v", testErr1, err)
+	}
+	err := fn("boom", nil)
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected *PanicError, got %T", err)
+	}
+	if pe.Value != "boom" {
+		t.Errorf("expected panic value %q, got %v", "boom", pe.Value)
+	}
+	if !bytes.Contains(pe.Stack, []byte("TestRecover")) {
+		t.Errorf("expected stack to contain the panicking function, got:\n%s", pe.Stack)
+	}
+}
+
+func TestCatch(t *testing.T) {
+	if err := Catch(func() error { return nil }); err != nil {
+		t.Errorf("expected nil error, got %v", err)
+	}
+	if err := Catch(func() error { return testErr1 }); !errors.Is(err, testErr1) {
+		t.Errorf("expected=%v, got=%v", testErr1, err)
+	}
+	err := Catch(func() error { panic(testErr2) })
+	testExpectTrueHelper(t, errors.Is(err, testErr2), "errors.Is(err, testErr2)")
+	testExpectTrueHelper(t, err.Error() == "panic: error: 2", `err.Error() == "panic: error: 2"`)
+}
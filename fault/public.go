@@ -0,0 +1,62 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import "errors"
+
+// WithPublicMessage wraps err with a message that is safe to show to end users.
+// The error message returned by Error is unchanged, so the detailed error is still available for logs.
+// If err is nil, WithPublicMessage returns nil.
+func WithPublicMessage(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	return &publicError{err: err, msg: msg}
+}
+
+// PublicMessage returns the message that is safe to show to end users, if the error or any of its wrapped errors
+// has one. An error has a public message if it implements the function `PublicMessage() string`.
+// When there is more than one, the outermost message is returned.
+func PublicMessage(err error) (string, bool) {
+	var asErr interface{ PublicMessage() string }
+	if errors.As(err, &asErr) {
+		return asErr.PublicMessage(), true
+	}
+	return "", false
+}
+
+type publicError struct {
+	err error
+	msg string
+}
+
+func (e *publicError) Error() string {
+	return e.err.Error()
+}
+
+func (e *publicError) Unwrap() error {
+	return e.err
+}
+
+func (e *publicError) PublicMessage() string {
+	return e.msg
+}
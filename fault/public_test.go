@@ -0,0 +1,69 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func ExamplePublicMessage() {
+	err := fmt.Errorf("query users: %w", errors.New("connection refused: 10.0.0.5:5432"))
+	err = WithPublicMessage(err, "The service is temporarily unavailable.")
+	msg, _ := PublicMessage(fmt.Errorf("handler: %w", err))
+	fmt.Println(msg)
+	fmt.Println(err)
+	// Output:
+	// The service is temporarily unavailable.
+	// query users: connection refused: 10.0.0.5:5432
+}
+
+func TestPublicMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		errVal   error
+		expect   string
+		expectOK bool
+	}{
+		{name: "nil", errVal: nil},
+		{name: "standard-error", errVal: errors.New("standard error")},
+		{name: "public", errVal: WithPublicMessage(testErr1, "public"), expect: "public", expectOK: true},
+		{name: "outer-wins", errVal: WithPublicMessage(WithPublicMessage(testErr1, "inner"), "outer"), expect: "outer", expectOK: true},
+		{name: "wrapped", errVal: fmt.Errorf("test: %w", WithPublicMessage(testErr1, "public")), expect: "public", expectOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, ok := PublicMessage(tt.errVal)
+			if msg != tt.expect || ok != tt.expectOK {
+				t.Errorf("expected=(%q,%t), got=(%q,%t)", tt.expect, tt.expectOK, msg, ok)
+			}
+		})
+	}
+}
+
+func TestWithPublicMessage(t *testing.T) {
+	testExpectTrueHelper(t, WithPublicMessage(nil, "test") == nil, "WithPublicMessage(nil) == nil")
+	err := WithPublicMessage(testErr1, "test")
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+	testExpectTrueHelper(t, err.Error() == testErr1.Error(), "err.Error() == testErr1.Error()")
+}
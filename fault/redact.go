@@ -0,0 +1,81 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"fmt"
+	"io"
+)
+
+// RedactedPlaceholder is the text rendered in place of a redacted value.
+const RedactedPlaceholder = "[REDACTED]"
+
+// Secret holds a sensitive value, such as a password or token, that must not appear in error messages or logs.
+// It renders as RedactedPlaceholder when formatted with any fmt verb, or marshaled to JSON.
+// The value can only be retrieved by explicitly calling Unredact.
+type Secret struct {
+	value any
+}
+
+// Redacted wraps value in a Secret, so it can be passed to fmt.Errorf or With without leaking.
+//
+//	err = fault.With(err, "user", user, "password", fault.Redacted(password))
+func Redacted(value any) Secret {
+	return Secret{value: value}
+}
+
+// Unredact returns the sensitive value.
+func (s Secret) Unredact() any {
+	return s.value
+}
+
+// String returns RedactedPlaceholder.
+func (s Secret) String() string {
+	return RedactedPlaceholder
+}
+
+// GoString returns RedactedPlaceholder, so the value is hidden from %#v as well.
+func (s Secret) GoString() string {
+	return RedactedPlaceholder
+}
+
+// Format implements fmt.Formatter, rendering RedactedPlaceholder for every verb.
+func (s Secret) Format(f fmt.State, _ rune) {
+	_, _ = io.WriteString(f, RedactedPlaceholder)
+}
+
+// MarshalJSON encodes the Secret as the JSON string RedactedPlaceholder.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + RedactedPlaceholder + `"`), nil
+}
+
+// UnredactedFields is like Fields, but replaces each Secret with its sensitive value.
+// Use it only where the values are known to be handled safely.
+func UnredactedFields(err error) map[string]any {
+	fields := Fields(err)
+	for k, v := range fields {
+		if s, ok := v.(Secret); ok {
+			fields[k] = s.Unredact()
+		}
+	}
+	return fields
+}
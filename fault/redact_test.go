@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func ExampleRedacted() {
+	err := With(testErr1, "user", "alice", "password", Redacted("hunter2"))
+	fmt.Printf("%+v\n", err)
+	fmt.Println(fmt.Errorf("login with password %s failed", Redacted("hunter2")))
+	fmt.Println(UnredactedFields(err)["password"])
+	// Output:
+	// error: 1 password=[REDACTED] user=alice
+	// login with password [REDACTED] failed
+	// hunter2
+}
+
+func TestSecret_Format(t *testing.T) {
+	s := Redacted("hunter2")
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%d", "%x"} {
+		if actual := fmt.Sprintf(format, s); actual != RedactedPlaceholder {
+			t.Errorf("Sprintf(%q) = %q, want %q", format, actual, RedactedPlaceholder)
+		}
+	}
+	if s.Unredact() != "hunter2" {
+		t.Errorf("Unredact() = %v, want %v", s.Unredact(), "hunter2")
+	}
+}
+
+func TestSecret_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(map[string]any{"password": Redacted("hunter2")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expect := `{"password":"[REDACTED]"}`; string(data) != expect {
+		t.Errorf("expected=%s, got=%s", expect, data)
+	}
+}
+
+func TestFieldsError_Format(t *testing.T) {
+	err := With(testErr1, "b", 2, "a", Redacted(1))
+	tests := []struct {
+		format string
+		expect string
+	}{
+		{format: "%v", expect: "error: 1"},
+		{format: "%s", expect: "error: 1"},
+		{format: "%q", expect: `"error: 1"`},
+		{format: "%+v", expect: "error: 1 a=[REDACTED] b=2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if actual := fmt.Sprintf(tt.format, err); actual != tt.expect {
+				t.Errorf("expected=%q, got=%q", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestUnredactedFields(t *testing.T) {
+	testExpectTrueHelper(t, UnredactedFields(nil) == nil, "UnredactedFields(nil) == nil")
+	err := With(testErr1, "token", Redacted("secret"), "user", "alice")
+	fields := UnredactedFields(err)
+	testExpectTrueHelper(t, fields["token"] == "secret", `fields["token"] == "secret"`)
+	testExpectTrueHelper(t, fields["user"] == "alice", `fields["user"] == "alice"`)
+	_, redacted := Fields(err)["token"].(Secret)
+	testExpectTrueHelper(t, redacted, "Fields() keeps secrets redacted")
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"time"
+)
+
+// RetryableAfter wraps err so that IsRetryable reports true for it, and RetryAfter reports the suggested delay d
+// before the operation should be retried; for example, from an HTTP Retry-After header.
+// The error message is unchanged. If err is nil, RetryableAfter returns nil.
+func RetryableAfter(err error, d time.Duration) error {
+	if err == nil {
+		return nil
+	}
+	return &retryAfterError{retryableError: retryableError{err: err}, after: d}
+}
+
+// RetryAfter returns the suggested delay before retrying, if the error or any of its wrapped errors has one.
+// An error suggests a delay if it implements the function `RetryAfter() time.Duration`.
+func RetryAfter(err error) (time.Duration, bool) {
+	var asErr interface{ RetryAfter() time.Duration }
+	if errors.As(err, &asErr) {
+		return asErr.RetryAfter(), true
+	}
+	return 0, false
+}
+
+type retryAfterError struct {
+	retryableError
+	after time.Duration
+}
+
+func (e *retryAfterError) RetryAfter() time.Duration {
+	return e.after
+}
@@ -0,0 +1,59 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name     string
+		errVal   error
+		expect   time.Duration
+		expectOK bool
+	}{
+		{name: "nil", errVal: nil},
+		{name: "standard-error", errVal: errors.New("standard error")},
+		{name: "retryable-after", errVal: RetryableAfter(testErr1, time.Second), expect: time.Second, expectOK: true},
+		{name: "wrapped", errVal: fmt.Errorf("test: %w", RetryableAfter(testErr1, time.Minute)), expect: time.Minute, expectOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, ok := RetryAfter(tt.errVal)
+			if d != tt.expect || ok != tt.expectOK {
+				t.Errorf("expected=(%v,%t), got=(%v,%t)", tt.expect, tt.expectOK, d, ok)
+			}
+		})
+	}
+}
+
+func TestRetryableAfter(t *testing.T) {
+	testExpectTrueHelper(t, RetryableAfter(nil, time.Second) == nil, "RetryableAfter(nil) == nil")
+	err := RetryableAfter(testErr1, time.Second)
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+	testExpectTrueHelper(t, IsRetryable(err), "IsRetryable(err)")
+	testExpectTrueHelper(t, err.Error() == testErr1.Error(), "err.Error() == testErr1.Error()")
+}
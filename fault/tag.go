@@ -0,0 +1,96 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+// Tag is a lightweight label that can be attached to any error using Tagged, and tested for using errors.Is.
+// Tags allow classifying errors across packages without defining a new type for every case.
+// Like Message, tags can be declared as constants:
+//
+//	const TagBilling = fault.Tag("billing")
+type Tag string
+
+// These are commonly used tags.
+const (
+	// TagTransient marks an error that is expected to go away on its own.
+	TagTransient = Tag("transient")
+	// TagUserInput marks an error that was caused by invalid input from a user.
+	TagUserInput = Tag("user_input")
+)
+
+func (t Tag) Error() string {
+	return "tag: " + string(t)
+}
+
+// Tagged wraps err with the given tags, so that errors.Is(err, tag) reports true for each of them.
+// The error message is unchanged. If err is nil, Tagged returns nil.
+func Tagged(err error, tags ...Tag) error {
+	if err == nil {
+		return nil
+	}
+	return &taggedError{err: err, tags: tags}
+}
+
+// Tags returns all the tags attached to err and any of its wrapped errors, outermost first, without duplicates.
+func Tags(err error) []Tag {
+	var result []Tag
+	seen := make(map[Tag]struct{})
+	for e := range Chain(err) {
+		te, ok := e.(*taggedError)
+		if !ok {
+			continue
+		}
+		for _, tag := range te.tags {
+			if _, ok := seen[tag]; !ok {
+				seen[tag] = struct{}{}
+				result = append(result, tag)
+			}
+		}
+	}
+	return result
+}
+
+type taggedError struct {
+	err  error
+	tags []Tag
+}
+
+func (e *taggedError) Error() string {
+	return e.err.Error()
+}
+
+func (e *taggedError) Unwrap() error {
+	return e.err
+}
+
+// Is reports whether target is one of the error's tags.
+func (e *taggedError) Is(target error) bool {
+	tag, ok := target.(Tag)
+	if !ok {
+		return false
+	}
+	for _, t := range e.tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
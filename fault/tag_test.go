@@ -0,0 +1,78 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+const testTag = Tag("test")
+
+func ExampleTagged() {
+	err := Tagged(errors.New("invalid email address"), TagUserInput)
+	err = fmt.Errorf("create account: %w", err)
+	fmt.Println(err)
+	fmt.Println(errors.Is(err, TagUserInput))
+	fmt.Println(errors.Is(err, TagTransient))
+	// Output:
+	// create account: invalid email address
+	// true
+	// false
+}
+
+func TestTagged(t *testing.T) {
+	testExpectTrueHelper(t, Tagged(nil, TagTransient) == nil, "Tagged(nil) == nil")
+	err := Tagged(testErr1, TagTransient, testTag)
+	testExpectTrueHelper(t, err.Error() == testErr1.Error(), "err.Error() == testErr1.Error()")
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+	testExpectTrueHelper(t, errors.Is(err, TagTransient), "errors.Is(err, TagTransient)")
+	testExpectTrueHelper(t, errors.Is(err, testTag), "errors.Is(err, testTag)")
+	testExpectTrueHelper(t, !errors.Is(err, TagUserInput), "!errors.Is(err, TagUserInput)")
+	testExpectTrueHelper(t, !errors.Is(err, testErr2), "!errors.Is(err, testErr2)")
+}
+
+func TestTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		errVal error
+		expect []Tag
+	}{
+		{name: "nil", errVal: nil, expect: nil},
+		{name: "untagged", errVal: testErr1, expect: nil},
+		{name: "tagged", errVal: Tagged(testErr1, testTag), expect: []Tag{testTag}},
+		{
+			name:   "nested",
+			errVal: Tagged(fmt.Errorf("a: %w", Tagged(testErr1, TagTransient, testTag)), testTag, TagUserInput),
+			expect: []Tag{testTag, TagUserInput, TagTransient},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := Tags(tt.errVal)
+			if fmt.Sprint(actual) != fmt.Sprint(tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
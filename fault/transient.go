@@ -0,0 +1,122 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import "errors"
+
+// IsTemporary checks if the error or any of its wrapped errors is a Temporary error.
+// a Temporary error implements the function `Temporary() bool` and returns true.
+// This includes net.Error values that report themselves as temporary.
+func IsTemporary(err error) bool {
+	var asErr interface{ Temporary() bool }
+	return errors.As(err, &asErr) && asErr.Temporary()
+}
+
+// IsTimeout checks if the error or any of its wrapped errors is a Timeout error.
+// a Timeout error implements the function `Timeout() bool` and returns true.
+// This includes net.Error values that report a timeout, and os.ErrDeadlineExceeded.
+func IsTimeout(err error) bool {
+	var asErr interface{ Timeout() bool }
+	return errors.As(err, &asErr) && asErr.Timeout()
+}
+
+// IsRetryable checks if the error or any of its wrapped errors is a Retryable error.
+// a Retryable error implements the function `Retryable() bool` and returns true.
+func IsRetryable(err error) bool {
+	var asErr interface{ Retryable() bool }
+	return errors.As(err, &asErr) && asErr.Retryable()
+}
+
+// MarkTemporary wraps err so that IsTemporary reports true for it.
+// The error message is unchanged. If err is nil, MarkTemporary returns nil.
+func MarkTemporary(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &temporaryError{err: err}
+}
+
+// MarkTimeout wraps err so that IsTimeout reports true for it.
+// The error message is unchanged. If err is nil, MarkTimeout returns nil.
+func MarkTimeout(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &timeoutError{err: err}
+}
+
+// MarkRetryable wraps err so that IsRetryable reports true for it.
+// The error message is unchanged. If err is nil, MarkRetryable returns nil.
+func MarkRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &retryableError{err: err}
+}
+
+type temporaryError struct {
+	err error
+}
+
+func (e *temporaryError) Error() string {
+	return e.err.Error()
+}
+
+func (e *temporaryError) Unwrap() error {
+	return e.err
+}
+
+func (e *temporaryError) Temporary() bool {
+	return true
+}
+
+type timeoutError struct {
+	err error
+}
+
+func (e *timeoutError) Error() string {
+	return e.err.Error()
+}
+
+func (e *timeoutError) Unwrap() error {
+	return e.err
+}
+
+func (e *timeoutError) Timeout() bool {
+	return true
+}
+
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
+
+func (e *retryableError) Retryable() bool {
+	return true
+}
@@ -0,0 +1,127 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package fault
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"testing"
+)
+
+type testNetError struct {
+	timeout   bool
+	temporary bool
+}
+
+func (e *testNetError) Error() string {
+	return "net error"
+}
+
+func (e *testNetError) Timeout() bool {
+	return e.timeout
+}
+
+func (e *testNetError) Temporary() bool {
+	return e.temporary
+}
+
+var _ net.Error = (*testNetError)(nil)
+
+func TestIsTemporary(t *testing.T) {
+	tests := []struct {
+		name   string
+		errVal error
+		expect bool
+	}{
+		{name: "nil", errVal: nil, expect: false},
+		{name: "standard-error", errVal: errors.New("standard error"), expect: false},
+		{name: "net-temporary", errVal: &testNetError{temporary: true}, expect: true},
+		{name: "net-not-temporary", errVal: &testNetError{timeout: true}, expect: false},
+		{name: "wrapped-net-temporary", errVal: fmt.Errorf("test: %w", &testNetError{temporary: true}), expect: true},
+		{name: "marked", errVal: MarkTemporary(errors.New("test")), expect: true},
+		{name: "wrapped-marked", errVal: fmt.Errorf("test: %w", MarkTemporary(errors.New("test"))), expect: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := IsTemporary(tt.errVal)
+			if tt.expect != actual {
+				t.Errorf("expected=%t, got=%t", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestIsTimeout(t *testing.T) {
+	tests := []struct {
+		name   string
+		errVal error
+		expect bool
+	}{
+		{name: "nil", errVal: nil, expect: false},
+		{name: "standard-error", errVal: errors.New("standard error"), expect: false},
+		{name: "net-timeout", errVal: &testNetError{timeout: true}, expect: true},
+		{name: "net-not-timeout", errVal: &testNetError{temporary: true}, expect: false},
+		{name: "deadline-exceeded", errVal: fmt.Errorf("test: %w", os.ErrDeadlineExceeded), expect: true},
+		{name: "marked", errVal: MarkTimeout(errors.New("test")), expect: true},
+		{name: "wrapped-marked", errVal: fmt.Errorf("test: %w", MarkTimeout(errors.New("test"))), expect: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := IsTimeout(tt.errVal)
+			if tt.expect != actual {
+				t.Errorf("expected=%t, got=%t", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name   string
+		errVal error
+		expect bool
+	}{
+		{name: "nil", errVal: nil, expect: false},
+		{name: "standard-error", errVal: errors.New("standard error"), expect: false},
+		{name: "marked", errVal: MarkRetryable(errors.New("test")), expect: true},
+		{name: "wrapped-marked", errVal: fmt.Errorf("test: %w", MarkRetryable(errors.New("test"))), expect: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := IsRetryable(tt.errVal)
+			if tt.expect != actual {
+				t.Errorf("expected=%t, got=%t", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestMarkRetryable(t *testing.T) {
+	testExpectTrueHelper(t, MarkRetryable(nil) == nil, "MarkRetryable(nil) == nil")
+	testExpectTrueHelper(t, MarkTemporary(nil) == nil, "MarkTemporary(nil) == nil")
+	testExpectTrueHelper(t, MarkTimeout(nil) == nil, "MarkTimeout(nil) == nil")
+	err := MarkRetryable(testErr1)
+	testExpectTrueHelper(t, errors.Is(err, testErr1), "errors.Is(err, testErr1)")
+	testExpectTrueHelper(t, err.Error() == testErr1.Error(), "err.Error() == testErr1.Error()")
+}
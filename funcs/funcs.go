@@ -0,0 +1,166 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package funcs contains wrappers which control how often a function is called.
+package funcs
+
+import (
+	"sync"
+	"time"
+
+	"github.com/justenwalker/got/clock"
+)
+
+// Option configures Debounce and Throttle.
+type Option func(*options)
+
+type options struct {
+	leading  bool
+	trailing bool
+	clock    clock.Clock
+}
+
+// Leading sets whether the function is called on the leading edge: immediately, on the first call of a burst.
+func Leading(enabled bool) Option {
+	return func(o *options) {
+		o.leading = enabled
+	}
+}
+
+// Trailing sets whether the function is called on the trailing edge: at the end of the wait, if it was called during it.
+func Trailing(enabled bool) Option {
+	return func(o *options) {
+		o.trailing = enabled
+	}
+}
+
+// WithClock sets the clock used to measure the wait. By default, it is clock.Real.
+func WithClock(clk clock.Clock) Option {
+	return func(o *options) {
+		o.clock = clk
+	}
+}
+
+func newOptions(leading, trailing bool, opts []Option) options {
+	o := options{leading: leading, trailing: trailing, clock: clock.Real()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if !o.leading && !o.trailing {
+		// disabling the default edge enables the other one, rather than never calling the function.
+		o.leading, o.trailing = !leading, !trailing
+	}
+	return o
+}
+
+// Debounce returns a function which calls fn only once calls to it have stopped for the duration d.
+// Each call during the wait restarts it, so a steady stream of calls delays fn until the stream pauses.
+//
+// By default, fn is called on the trailing edge only. With Leading(true), fn is also called immediately
+// on the first call of a burst, and then on the trailing edge only if there were more calls during the wait.
+// Trailing(false) disables the trailing edge, leaving only the leading edge, even without Leading(true).
+//
+// The returned function is safe for concurrent use. Trailing calls to fn happen in another goroutine.
+func Debounce(fn func(), d time.Duration, opts ...Option) func() {
+	o := newOptions(false, true, opts)
+	var (
+		mu      sync.Mutex
+		timer   clock.Timer
+		pending bool
+		gen     uint64
+	)
+	fire := func(g uint64) {
+		mu.Lock()
+		if g != gen {
+			// the wait was restarted after this timer fired, but before it acquired the lock.
+			mu.Unlock()
+			return
+		}
+		call := pending && o.trailing
+		pending = false
+		timer = nil
+		mu.Unlock()
+		if call {
+			fn()
+		}
+	}
+	return func() {
+		mu.Lock()
+		leading := timer == nil && o.leading
+		if timer != nil {
+			timer.Stop()
+		}
+		pending = pending || !leading
+		gen++
+		g := gen
+		timer = o.clock.AfterFunc(d, func() { fire(g) })
+		mu.Unlock()
+		if leading {
+			fn()
+		}
+	}
+}
+
+// Throttle returns a function which calls fn at most once per interval d.
+//
+// By default, fn is called on the leading edge only: the first call is passed through immediately,
+// and calls during the following interval are dropped. With Trailing(true), a call during the interval
+// is not dropped, but deferred until the end of the interval, which then starts a new interval.
+// Leading(false) disables the leading edge, leaving only the trailing edge, even without Trailing(true).
+//
+// The returned function is safe for concurrent use. Trailing calls to fn happen in another goroutine.
+func Throttle(fn func(), d time.Duration, opts ...Option) func() {
+	o := newOptions(true, false, opts)
+	var (
+		mu      sync.Mutex
+		active  bool
+		pending bool
+		end     func()
+	)
+	end = func() {
+		mu.Lock()
+		if !pending || !o.trailing {
+			active = false
+			pending = false
+			mu.Unlock()
+			return
+		}
+		pending = false
+		o.clock.AfterFunc(d, end)
+		mu.Unlock()
+		fn()
+	}
+	return func() {
+		mu.Lock()
+		if active {
+			pending = true
+			mu.Unlock()
+			return
+		}
+		active = true
+		pending = !o.leading
+		o.clock.AfterFunc(d, end)
+		mu.Unlock()
+		if o.leading {
+			fn()
+		}
+	}
+}
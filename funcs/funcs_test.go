@@ -0,0 +1,137 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package funcs
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/clock"
+)
+
+// step is a call to the wrapped function, or time passing if wait is set.
+type step struct {
+	wait time.Duration
+}
+
+var call = step{}
+
+func wait(d time.Duration) step {
+	return step{wait: d}
+}
+
+func runSteps(clk *clock.Fake, wrapped func(), steps []step) {
+	for _, s := range steps {
+		if s.wait > 0 {
+			clk.Advance(s.wait)
+			continue
+		}
+		wrapped()
+	}
+}
+
+func TestDebounce(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []Option
+		steps  []step
+		expect int32
+	}{
+		{name: "single", steps: []step{call, wait(time.Second)}, expect: 1},
+		{name: "not yet", steps: []step{call, wait(time.Second - 1)}, expect: 0},
+		{name: "burst", steps: []step{call, wait(500 * time.Millisecond), call, wait(500 * time.Millisecond), call, wait(time.Second)}, expect: 1},
+		{name: "two bursts", steps: []step{call, call, wait(time.Second), call, wait(time.Second)}, expect: 2},
+		{name: "leading", opts: []Option{Leading(true)}, steps: []step{call}, expect: 1},
+		{name: "leading single", opts: []Option{Leading(true)}, steps: []step{call, wait(time.Second)}, expect: 1},
+		{name: "leading burst", opts: []Option{Leading(true)}, steps: []step{call, call, wait(time.Second)}, expect: 2},
+		{name: "leading only", opts: []Option{Leading(true), Trailing(false)}, steps: []step{call, call, wait(time.Second), call}, expect: 2},
+		{name: "trailing disabled", opts: []Option{Trailing(false)}, steps: []step{call, call, wait(time.Second), call}, expect: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := clock.NewFake(time.Unix(0, 0))
+			var n atomic.Int32
+			fn := Debounce(func() { n.Add(1) }, time.Second, append(tt.opts, WithClock(clk))...)
+			runSteps(clk, fn, tt.steps)
+			if got := n.Load(); got != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []Option
+		steps  []step
+		expect int32
+	}{
+		{name: "single", steps: []step{call}, expect: 1},
+		{name: "dropped", steps: []step{call, call, call, wait(time.Second)}, expect: 1},
+		{name: "next interval", steps: []step{call, wait(time.Second), call}, expect: 2},
+		{name: "trailing", opts: []Option{Trailing(true)}, steps: []step{call, call, call, wait(time.Second)}, expect: 2},
+		{name: "trailing starts interval", opts: []Option{Trailing(true)}, steps: []step{call, call, wait(time.Second), call, wait(time.Second - 1)}, expect: 2},
+		{name: "trailing only", opts: []Option{Leading(false), Trailing(true)}, steps: []step{call, call, wait(time.Second)}, expect: 1},
+		{name: "leading disabled", opts: []Option{Leading(false)}, steps: []step{call, call, wait(time.Second)}, expect: 1},
+		{name: "steady", opts: []Option{Trailing(true)}, steps: []step{
+			call, wait(300 * time.Millisecond), call, wait(300 * time.Millisecond), call, wait(300 * time.Millisecond),
+			call, wait(300 * time.Millisecond), call, wait(300 * time.Millisecond), call, wait(300 * time.Millisecond),
+		}, expect: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clk := clock.NewFake(time.Unix(0, 0))
+			var n atomic.Int32
+			fn := Throttle(func() { n.Add(1) }, time.Second, append(tt.opts, WithClock(clk))...)
+			runSteps(clk, fn, tt.steps)
+			if got := n.Load(); got != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestDebounce_concurrent(t *testing.T) {
+	var n atomic.Int32
+	fn := Debounce(func() { n.Add(1) }, 10*time.Millisecond)
+	done := make(chan struct{})
+	for range 4 {
+		go func() {
+			for range 100 {
+				fn()
+			}
+			done <- struct{}{}
+		}()
+	}
+	for range 4 {
+		<-done
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for n.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := n.Load(); got < 1 {
+		t.Errorf("expected at least one call, got=%v", got)
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package future provides a Future type, which holds the result of an asynchronous operation
+// that may not have completed yet.
+//
+// A Future is completed exactly once, either by calling Complete directly, or by starting
+// a function with Go. Any number of goroutines may wait for the result with Get or Done.
+package future
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/justenwalker/got/fault"
+)
+
+// ErrNoFutures is the error returned by Any when it is called with no futures.
+const ErrNoFutures = fault.Message("future: no futures provided")
+
+// Future holds a value and an error that become available once the future is completed.
+//
+// A Future must be created with New or Go, and is safe for concurrent use.
+type Future[T any] struct {
+	done  chan struct{}
+	once  sync.Once
+	value T
+	err   error
+}
+
+// New creates a new incomplete Future.
+func New[T any]() *Future[T] {
+	return &Future[T]{
+		done: make(chan struct{}),
+	}
+}
+
+// Go calls fn in a new goroutine and returns a Future which is completed with its result.
+func Go[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) *Future[T] {
+	f := New[T]()
+	go func() {
+		f.Complete(fn(ctx))
+	}()
+	return f
+}
+
+// Complete sets the result of the Future and wakes up anyone waiting for it.
+// Only the first call to Complete has any effect; it returns true if this call completed the Future.
+func (f *Future[T]) Complete(v T, err error) bool {
+	var completed bool
+	f.once.Do(func() {
+		f.value, f.err = v, err
+		close(f.done)
+		completed = true
+	})
+	return completed
+}
+
+// Done returns a channel that is closed when the Future is completed.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Get waits for the Future to be completed and returns its result.
+// If the context is done first, the context error is returned.
+func (f *Future[T]) Get(ctx context.Context) (T, error) {
+	select {
+	case <-f.done:
+		return f.value, f.err
+	default:
+	}
+	select {
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	case <-f.done:
+		return f.value, f.err
+	}
+}
+
+// Then returns a Future which is completed by calling fn with the value of f once it completes successfully.
+// If f completes with an error, fn is not called and the returned Future completes with the same error.
+func Then[A, B any](f *Future[A], fn func(a A) (B, error)) *Future[B] {
+	next := New[B]()
+	go func() {
+		<-f.done
+		if f.err != nil {
+			var zero B
+			next.Complete(zero, f.err)
+			return
+		}
+		next.Complete(fn(f.value))
+	}()
+	return next
+}
+
+// All returns a Future which completes with the values of all the given futures, in the same order.
+// If any of the futures completes with an error, the returned Future completes with that error
+// without waiting for the rest.
+func All[T any](fs ...*Future[T]) *Future[[]T] {
+	all := New[[]T]()
+	if len(fs) == 0 {
+		all.Complete([]T{}, nil)
+		return all
+	}
+	go func() {
+		values := make([]T, len(fs))
+		fail := make(chan error, len(fs))
+		for _, f := range fs {
+			go func(f *Future[T]) {
+				<-f.done
+				if f.err != nil {
+					fail <- f.err
+				}
+			}(f)
+		}
+		for i, f := range fs {
+			select {
+			case err := <-fail:
+				all.Complete(nil, err)
+				return
+			case <-f.done:
+				if f.err != nil {
+					all.Complete(nil, f.err)
+					return
+				}
+				values[i] = f.value
+			}
+		}
+		all.Complete(values, nil)
+	}()
+	return all
+}
+
+// Any returns a Future which completes with the value of the first of the given futures to complete successfully.
+// If all the futures complete with an error, the returned Future completes with all of the errors joined together.
+// If no futures are given, the returned Future completes with ErrNoFutures.
+func Any[T any](fs ...*Future[T]) *Future[T] {
+	first := New[T]()
+	if len(fs) == 0 {
+		var zero T
+		first.Complete(zero, ErrNoFutures)
+		return first
+	}
+	go func() {
+		errs := make([]error, len(fs))
+		var wg sync.WaitGroup
+		wg.Add(len(fs))
+		for i, f := range fs {
+			go func(i int, f *Future[T]) {
+				defer wg.Done()
+				select {
+				case <-first.done:
+				case <-f.done:
+					if f.err != nil {
+						errs[i] = f.err
+						return
+					}
+					first.Complete(f.value, nil)
+				}
+			}(i, f)
+		}
+		wg.Wait()
+		var zero T
+		first.Complete(zero, errors.Join(errs...))
+	}()
+	return first
+}
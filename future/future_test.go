@@ -0,0 +1,152 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func ExampleFuture() {
+	f := Go(context.Background(), func(ctx context.Context) (int, error) {
+		return 21, nil
+	})
+	doubled := Then(f, func(i int) (string, error) {
+		return strconv.Itoa(i * 2), nil
+	})
+	fmt.Println(doubled.Get(context.Background()))
+	// Output:
+	// 42 <nil>
+}
+
+func TestFuture_Complete(t *testing.T) {
+	f := New[int]()
+	select {
+	case <-f.Done():
+		t.Fatalf("expected future to be incomplete")
+	default:
+	}
+	if !f.Complete(1, nil) {
+		t.Errorf("expected first Complete() to return true")
+	}
+	if f.Complete(2, nil) {
+		t.Errorf("expected second Complete() to return false")
+	}
+	<-f.Done()
+	if v, err := f.Get(context.Background()); v != 1 || err != nil {
+		t.Errorf("Get() = (%v,%v), want (1,<nil>)", v, err)
+	}
+}
+
+func TestFuture_Get_contextDone(t *testing.T) {
+	f := New[int]()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := f.Get(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Get() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestThen(t *testing.T) {
+	testErr := errors.New("test")
+	t.Run("success", func(t *testing.T) {
+		f := New[int]()
+		next := Then(f, func(i int) (int, error) { return i + 1, nil })
+		f.Complete(1, nil)
+		if v, err := next.Get(context.Background()); v != 2 || err != nil {
+			t.Errorf("Get() = (%v,%v), want (2,<nil>)", v, err)
+		}
+	})
+	t.Run("error", func(t *testing.T) {
+		f := New[int]()
+		next := Then(f, func(i int) (int, error) {
+			t.Errorf("fn should not be called when the future fails")
+			return 0, nil
+		})
+		f.Complete(0, testErr)
+		if _, err := next.Get(context.Background()); !errors.Is(err, testErr) {
+			t.Errorf("Get() error = %v, want %v", err, testErr)
+		}
+	})
+}
+
+func TestAll(t *testing.T) {
+	testErr := errors.New("test")
+	t.Run("empty", func(t *testing.T) {
+		vs, err := All[int]().Get(context.Background())
+		if err != nil || len(vs) != 0 {
+			t.Errorf("Get() = (%v,%v), want ([],<nil>)", vs, err)
+		}
+	})
+	t.Run("success", func(t *testing.T) {
+		fs := []*Future[int]{New[int](), New[int](), New[int]()}
+		all := All(fs...)
+		fs[2].Complete(3, nil)
+		fs[0].Complete(1, nil)
+		fs[1].Complete(2, nil)
+		vs, err := all.Get(context.Background())
+		if err != nil || len(vs) != 3 || vs[0] != 1 || vs[1] != 2 || vs[2] != 3 {
+			t.Errorf("Get() = (%v,%v), want ([1 2 3],<nil>)", vs, err)
+		}
+	})
+	t.Run("fail_fast", func(t *testing.T) {
+		fs := []*Future[int]{New[int](), New[int]()}
+		all := All(fs...)
+		fs[1].Complete(0, testErr)
+		if _, err := all.Get(context.Background()); !errors.Is(err, testErr) {
+			t.Errorf("Get() error = %v, want %v", err, testErr)
+		}
+	})
+}
+
+func TestAny(t *testing.T) {
+	err1 := errors.New("err1")
+	err2 := errors.New("err2")
+	t.Run("empty", func(t *testing.T) {
+		if _, err := Any[int]().Get(context.Background()); !errors.Is(err, ErrNoFutures) {
+			t.Errorf("Get() error = %v, want %v", err, ErrNoFutures)
+		}
+	})
+	t.Run("first_success", func(t *testing.T) {
+		fs := []*Future[int]{New[int](), New[int](), New[int]()}
+		first := Any(fs...)
+		fs[0].Complete(0, err1)
+		fs[2].Complete(3, nil)
+		if v, err := first.Get(context.Background()); v != 3 || err != nil {
+			t.Errorf("Get() = (%v,%v), want (3,<nil>)", v, err)
+		}
+	})
+	t.Run("all_fail", func(t *testing.T) {
+		fs := []*Future[int]{New[int](), New[int]()}
+		first := Any(fs...)
+		fs[0].Complete(0, err1)
+		fs[1].Complete(0, err2)
+		_, err := first.Get(context.Background())
+		if !errors.Is(err, err1) || !errors.Is(err, err2) {
+			t.Errorf("Get() error = %v, want both %v and %v", err, err1, err2)
+		}
+	})
+}
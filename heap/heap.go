@@ -0,0 +1,181 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package heap contains a generic priority queue, which removes values in priority order.
+package heap
+
+import "cmp"
+
+// Item is a value held in a PriorityQueue. It is returned by Push, and can be passed to Fix or Remove
+// to update the queue after changing the Value, or to remove it.
+type Item[T any] struct {
+	// Value is the value of the item. After changing it, call Fix to restore the queue's ordering.
+	Value T
+	index int
+	seq   uint64
+}
+
+// PriorityQueue is a binary heap ordered by a comparison function: Pop always returns the value
+// with the highest priority, which is the smallest according to less.
+// A PriorityQueue is not safe for concurrent use.
+type PriorityQueue[T any] struct {
+	items  []*Item[T]
+	less   func(a, b T) bool
+	stable bool
+	seq    uint64
+}
+
+// New creates a PriorityQueue which pops the values for which less reports true first.
+// Values with equal priority are popped in an unspecified order.
+func New[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less}
+}
+
+// NewStable is like New, but values with equal priority are popped in the order they were pushed.
+func NewStable[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{less: less, stable: true}
+}
+
+// NewMin creates a PriorityQueue which pops the smallest values first.
+func NewMin[T cmp.Ordered]() *PriorityQueue[T] {
+	return New(cmp.Less[T])
+}
+
+// NewMax creates a PriorityQueue which pops the largest values first.
+func NewMax[T cmp.Ordered]() *PriorityQueue[T] {
+	return New(func(a, b T) bool { return cmp.Less(b, a) })
+}
+
+// Len returns the number of values in the queue.
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.items)
+}
+
+// Push adds v to the queue, and returns its Item.
+func (pq *PriorityQueue[T]) Push(v T) *Item[T] {
+	item := &Item[T]{Value: v, index: len(pq.items), seq: pq.seq}
+	pq.seq++
+	pq.items = append(pq.items, item)
+	pq.up(item.index)
+	return item
+}
+
+// Pop removes and returns the value with the highest priority. It returns false if the queue is empty.
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if len(pq.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.Remove(pq.items[0]), true
+}
+
+// Peek returns the value with the highest priority without removing it. It returns false if the queue is empty.
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.items[0].Value, true
+}
+
+// Fix restores the ordering of the queue after the Value of item has changed.
+// It does nothing if item is no longer in the queue.
+func (pq *PriorityQueue[T]) Fix(item *Item[T]) {
+	if !pq.contains(item) {
+		return
+	}
+	if !pq.down(item.index) {
+		pq.up(item.index)
+	}
+}
+
+// Remove removes item from the queue and returns its value.
+// It does nothing if item is no longer in the queue.
+func (pq *PriorityQueue[T]) Remove(item *Item[T]) T {
+	if !pq.contains(item) {
+		return item.Value
+	}
+	i, last := item.index, len(pq.items)-1
+	if i != last {
+		pq.swap(i, last)
+	}
+	pq.items[last] = nil
+	pq.items = pq.items[:last]
+	if i != last {
+		if !pq.down(i) {
+			pq.up(i)
+		}
+	}
+	item.index = -1
+	return item.Value
+}
+
+func (pq *PriorityQueue[T]) contains(item *Item[T]) bool {
+	return item.index >= 0 && item.index < len(pq.items) && pq.items[item.index] == item
+}
+
+func (pq *PriorityQueue[T]) lessAt(i, j int) bool {
+	a, b := pq.items[i], pq.items[j]
+	if pq.less(a.Value, b.Value) {
+		return true
+	}
+	if pq.stable && !pq.less(b.Value, a.Value) {
+		return a.seq < b.seq
+	}
+	return false
+}
+
+func (pq *PriorityQueue[T]) swap(i, j int) {
+	pq.items[i], pq.items[j] = pq.items[j], pq.items[i]
+	pq.items[i].index = i
+	pq.items[j].index = j
+}
+
+func (pq *PriorityQueue[T]) up(j int) {
+	for j > 0 {
+		i := (j - 1) / 2
+		if !pq.lessAt(j, i) {
+			break
+		}
+		pq.swap(i, j)
+		j = i
+	}
+}
+
+// down moves the item at i0 down the heap, and reports whether it moved.
+func (pq *PriorityQueue[T]) down(i0 int) bool {
+	i, n := i0, len(pq.items)
+	for {
+		j := 2*i + 1
+		if j >= n {
+			break
+		}
+		if r := j + 1; r < n && pq.lessAt(r, j) {
+			j = r
+		}
+		if !pq.lessAt(j, i) {
+			break
+		}
+		pq.swap(i, j)
+		i = j
+	}
+	return i > i0
+}
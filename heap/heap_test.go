@@ -0,0 +1,119 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package heap
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func ExampleNewMin() {
+	pq := NewMin[int]()
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		pq.Push(v)
+	}
+	for pq.Len() > 0 {
+		v, _ := pq.Pop()
+		fmt.Print(v, " ")
+	}
+	fmt.Println()
+	// Output:
+	// 1 2 3 4 5
+}
+
+func testDrain[T any](pq *PriorityQueue[T]) []T {
+	var result []T
+	for {
+		v, ok := pq.Pop()
+		if !ok {
+			return result
+		}
+		result = append(result, v)
+	}
+}
+
+func TestPriorityQueue(t *testing.T) {
+	values := rand.Perm(100)
+	minQ, maxQ := NewMin[int](), NewMax[int]()
+	for _, v := range values {
+		minQ.Push(v)
+		maxQ.Push(v)
+	}
+	if v, ok := minQ.Peek(); !ok || v != 0 {
+		t.Errorf("Peek: expected=0, got=%v", v)
+	}
+	sorted := slices.Sorted(slices.Values(values))
+	if actual := testDrain(minQ); !slices.Equal(actual, sorted) {
+		t.Errorf("expected=%v, got=%v", sorted, actual)
+	}
+	slices.Reverse(sorted)
+	if actual := testDrain(maxQ); !slices.Equal(actual, sorted) {
+		t.Errorf("expected=%v, got=%v", sorted, actual)
+	}
+	if _, ok := minQ.Pop(); ok {
+		t.Errorf("expected empty Pop to fail")
+	}
+	if _, ok := minQ.Peek(); ok {
+		t.Errorf("expected empty Peek to fail")
+	}
+}
+
+func TestPriorityQueue_FixRemove(t *testing.T) {
+	pq := NewMin[int]()
+	items := make([]*Item[int], 10)
+	for i := range items {
+		items[i] = pq.Push(i * 10)
+	}
+	items[9].Value = -1
+	pq.Fix(items[9])
+	items[0].Value = 55
+	pq.Fix(items[0])
+	if v := pq.Remove(items[5]); v != 50 {
+		t.Errorf("Remove: expected=50, got=%v", v)
+	}
+	pq.Remove(items[5])
+	pq.Fix(items[5])
+	expect := []int{-1, 10, 20, 30, 40, 55, 60, 70, 80}
+	if actual := testDrain(pq); !slices.Equal(actual, expect) {
+		t.Errorf("expected=%v, got=%v", expect, actual)
+	}
+}
+
+func TestNewStable(t *testing.T) {
+	type task struct {
+		priority int
+		name     string
+	}
+	pq := NewStable(func(a, b task) bool { return a.priority < b.priority })
+	for i, p := range []int{2, 1, 2, 1, 2, 1} {
+		pq.Push(task{priority: p, name: fmt.Sprint(i)})
+	}
+	var names []string
+	for _, tk := range testDrain(pq) {
+		names = append(names, tk.name)
+	}
+	if expect := []string{"1", "3", "5", "0", "2", "4"}; !slices.Equal(names, expect) {
+		t.Errorf("expected=%v, got=%v", expect, names)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package iterx
+
+import (
+	"context"
+	"iter"
+
+	"github.com/justenwalker/got/chans"
+)
+
+// MapConcurrent returns a sequence of the results of applying fn to each value of seq, using up to n goroutines.
+// The results are yielded in the same order as the input, with the error returned by fn for each value.
+//
+// If ctx is done before seq is exhausted, the sequence yields the context error with the zero value of B, and ends.
+// When the consumer stops early, the context passed to fn is cancelled, and the remaining values of seq are not consumed.
+// If n is less than 1, it is treated as 1.
+func MapConcurrent[A, B any](ctx context.Context, seq iter.Seq[A], n int, fn func(ctx context.Context, a A) (B, error)) iter.Seq2[B, error] {
+	return mapConcurrent(ctx, seq, n, fn, chans.Map[A, result[B]])
+}
+
+// MapConcurrentUnordered is like MapConcurrent, but yields each result as soon as it is ready, regardless of input order.
+func MapConcurrentUnordered[A, B any](ctx context.Context, seq iter.Seq[A], n int, fn func(ctx context.Context, a A) (B, error)) iter.Seq2[B, error] {
+	return mapConcurrent(ctx, seq, n, fn, chans.MapUnordered[A, result[B]])
+}
+
+type result[T any] struct {
+	value T
+	err   error
+}
+
+type chanMapper[A, B any] func(ctx context.Context, in <-chan A, n int, fn func(a A) B) <-chan B
+
+func mapConcurrent[A, B any](ctx context.Context, seq iter.Seq[A], n int, fn func(ctx context.Context, a A) (B, error), mapper chanMapper[A, result[B]]) iter.Seq2[B, error] {
+	return func(yield func(B, error) bool) {
+		inner, cancel := context.WithCancel(ctx)
+		defer cancel()
+		in := make(chan A)
+		go func() {
+			defer close(in)
+			for a := range seq {
+				if chans.SendContext(inner, in, a) != nil {
+					return
+				}
+			}
+		}()
+		out := mapper(inner, in, n, func(a A) result[B] {
+			b, err := fn(inner, a)
+			return result[B]{value: b, err: err}
+		})
+		defer func() {
+			cancel()
+			// wait for the workers to notice the cancellation.
+			for range out {
+			}
+		}()
+		for r := range out {
+			if !yield(r.value, r.err) {
+				return
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			var zero B
+			yield(zero, err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package iterx
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMapConcurrent(t *testing.T) {
+	var running, peak atomic.Int32
+	double := func(_ context.Context, n int) (int, error) {
+		cur := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if cur <= p || peak.CompareAndSwap(p, cur) {
+				break
+			}
+		}
+		// later values finish first, to check the order is preserved.
+		time.Sleep(time.Duration(10-n) * time.Millisecond)
+		if n == 5 {
+			return 0, errors.New("five")
+		}
+		return n * 2, nil
+	}
+	var got []int
+	var errs int
+	for v, err := range MapConcurrent(context.Background(), slices.Values([]int{1, 2, 3, 4, 5, 6}), 3, double) {
+		if err != nil {
+			errs++
+			continue
+		}
+		got = append(got, v)
+	}
+	if !slices.Equal(got, []int{2, 4, 6, 8, 12}) {
+		t.Errorf("expected=[2 4 6 8 12], got=%v", got)
+	}
+	if errs != 1 {
+		t.Errorf("expected=1 error, got=%v", errs)
+	}
+	if p := peak.Load(); p > 3 {
+		t.Errorf("expected at most 3 concurrent calls, got=%v", p)
+	}
+}
+
+func TestMapConcurrentUnordered(t *testing.T) {
+	identity := func(_ context.Context, n int) (int, error) { return n, nil }
+	got := slices.Sorted(Keys(MapConcurrentUnordered(context.Background(), slices.Values([]int{3, 1, 2}), 2, identity)))
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("expected=[1 2 3], got=%v", got)
+	}
+}
+
+func TestMapConcurrent_stopEarly(t *testing.T) {
+	var consumed atomic.Int32
+	seq := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			consumed.Add(1)
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	identity := func(_ context.Context, n int) (int, error) { return n, nil }
+	for v := range MapConcurrent(context.Background(), seq, 2, identity) {
+		if v == 3 {
+			break
+		}
+	}
+	if c := consumed.Load(); c > 10 {
+		t.Errorf("expected the input to stop being consumed, got=%v values", c)
+	}
+}
+
+func TestMapConcurrent_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	identity := func(_ context.Context, n int) (int, error) { return n, nil }
+	var lastErr error
+	for _, err := range MapConcurrent(ctx, slices.Values([]int{1, 2, 3}), 2, identity) {
+		lastErr = err
+	}
+	if !errors.Is(lastErr, context.Canceled) {
+		t.Errorf("expected=%v, got=%v", context.Canceled, lastErr)
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package iterx contains functions for transforming and consuming iter.Seq and iter.Seq2 sequences.
+//
+// The functions returning sequences are lazy: they do not consume their input until the result is iterated,
+// and stop consuming it as soon as the consumer stops.
+package iterx
+
+import (
+	"iter"
+
+	"github.com/justenwalker/got/tuple"
+)
+
+// Map returns a sequence of the results of applying fn to each value of seq.
+func Map[A, B any](seq iter.Seq[A], fn func(a A) B) iter.Seq[B] {
+	return func(yield func(B) bool) {
+		for a := range seq {
+			if !yield(fn(a)) {
+				return
+			}
+		}
+	}
+}
+
+// Map2 returns a sequence of the results of applying fn to each pair of seq.
+func Map2[K1, V1, K2, V2 any](seq iter.Seq2[K1, V1], fn func(k K1, v V1) (K2, V2)) iter.Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) {
+		for k, v := range seq {
+			if !yield(fn(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a sequence of the values of seq for which pred returns true.
+func Filter[T any](seq iter.Seq[T], pred func(t T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for t := range seq {
+			if pred(t) && !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Filter2 returns a sequence of the pairs of seq for which pred returns true.
+func Filter2[K, V any](seq iter.Seq2[K, V], pred func(k K, v V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if pred(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce combines the values of seq into a single value, by calling fn with the result so far and each value in turn,
+// starting with init.
+func Reduce[T, R any](seq iter.Seq[T], init R, fn func(acc R, t T) R) R {
+	acc := init
+	for t := range seq {
+		acc = fn(acc, t)
+	}
+	return acc
+}
+
+// Take returns a sequence of the first n values of seq.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for t := range seq {
+			if !yield(t) {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a sequence of the values of seq after the first n.
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for t := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns a sequence of consecutive slices of up to n values of seq. Only the last slice may be shorter than n.
+// Each slice is newly allocated, so it may be retained. Chunk panics if n is less than 1.
+func Chunk[T any](seq iter.Seq[T], n int) iter.Seq[[]T] {
+	if n < 1 {
+		panic("iterx: chunk size must be at least 1")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, n)
+		for t := range seq {
+			chunk = append(chunk, t)
+			if len(chunk) == n {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, n)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Zip returns a sequence of pairs of the values of a and b, in order. It stops when either sequence ends.
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(b)
+		defer stop()
+		for va := range a {
+			vb, ok := nextB()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// Flatten returns a sequence of the values of each sequence of seqs, in order.
+func Flatten[T any](seqs iter.Seq[iter.Seq[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for seq := range seqs {
+			for t := range seq {
+				if !yield(t) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Collect returns the values of seq in a new slice.
+func Collect[T any](seq iter.Seq[T]) []T {
+	var s []T
+	for t := range seq {
+		s = append(s, t)
+	}
+	return s
+}
+
+// Collect2 returns the pairs of seq in a new slice.
+func Collect2[K, V any](seq iter.Seq2[K, V]) []tuple.Pair[K, V] {
+	var s []tuple.Pair[K, V]
+	for k, v := range seq {
+		s = append(s, tuple.NewPair(k, v))
+	}
+	return s
+}
+
+// Keys returns a sequence of the keys of the pairs of seq.
+func Keys[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence of the values of the pairs of seq.
+func Values[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
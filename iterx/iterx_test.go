@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package iterx
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/justenwalker/got/tuple"
+)
+
+func ExampleMap() {
+	squares := Map(slices.Values([]int{1, 2, 3, 4, 5}), func(n int) int { return n * n })
+	odd := Filter(squares, func(n int) bool { return n%2 == 1 })
+	fmt.Println(Collect(odd))
+	fmt.Println(Reduce(odd, 0, func(acc, n int) int { return acc + n }))
+	// Output:
+	// [1 9 25]
+	// 35
+}
+
+func TestTakeSkip(t *testing.T) {
+	tests := []struct {
+		name   string
+		seq    func([]int) []int
+		expect []int
+	}{
+		{name: "take", seq: func(s []int) []int { return Collect(Take(slices.Values(s), 2)) }, expect: []int{1, 2}},
+		{name: "take-zero", seq: func(s []int) []int { return Collect(Take(slices.Values(s), 0)) }, expect: nil},
+		{name: "take-more", seq: func(s []int) []int { return Collect(Take(slices.Values(s), 10)) }, expect: []int{1, 2, 3, 4}},
+		{name: "skip", seq: func(s []int) []int { return Collect(Skip(slices.Values(s), 3)) }, expect: []int{4}},
+		{name: "skip-all", seq: func(s []int) []int { return Collect(Skip(slices.Values(s), 10)) }, expect: nil},
+		{name: "skip-take", seq: func(s []int) []int { return Collect(Take(Skip(slices.Values(s), 1), 2)) }, expect: []int{2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.seq([]int{1, 2, 3, 4}); !slices.Equal(got, tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	chunks := Collect(Chunk(slices.Values([]int{1, 2, 3, 4, 5}), 2))
+	if fmt.Sprint(chunks) != "[[1 2] [3 4] [5]]" {
+		t.Errorf("expected=[[1 2] [3 4] [5]], got=%v", chunks)
+	}
+	first := Collect(Take(Chunk(slices.Values([]int{1, 2, 3}), 2), 1))
+	if fmt.Sprint(first) != "[[1 2]]" {
+		t.Errorf("expected=[[1 2]], got=%v", first)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+	Chunk(slices.Values([]int{1}), 0)
+}
+
+func TestZip(t *testing.T) {
+	pairs := Collect2(Zip(slices.Values([]int{1, 2, 3}), slices.Values([]string{"a", "b"})))
+	expect := []tuple.Pair[int, string]{tuple.NewPair(1, "a"), tuple.NewPair(2, "b")}
+	if !slices.Equal(pairs, expect) {
+		t.Errorf("expected=%v, got=%v", expect, pairs)
+	}
+	for range Zip(slices.Values([]int{1, 2}), slices.Values([]int{3, 4})) {
+		break
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	seqs := slices.Values([]iter.Seq[int]{slices.Values([]int{1, 2}), slices.Values([]int(nil)), slices.Values([]int{3})})
+	if got := Collect(Flatten(seqs)); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("expected=[1 2 3], got=%v", got)
+	}
+	if got := Collect(Take(Flatten(seqs), 1)); !slices.Equal(got, []int{1}) {
+		t.Errorf("expected=[1], got=%v", got)
+	}
+}
+
+func TestSeq2(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	big := Filter2(maps.All(m), func(_ string, v int) bool { return v > 1 })
+	strs := Map2(big, func(k string, v int) (string, string) { return k, k + strconv.Itoa(v) })
+	if got := slices.Sorted(Values(strs)); !slices.Equal(got, []string{"b2", "c3"}) {
+		t.Errorf("expected=[b2 c3], got=%v", got)
+	}
+	if got := slices.Sorted(Keys(strs)); !slices.Equal(got, []string{"b", "c"}) {
+		t.Errorf("expected=[b c], got=%v", got)
+	}
+}
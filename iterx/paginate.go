@@ -0,0 +1,64 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package iterx
+
+import (
+	"context"
+	"iter"
+)
+
+// Paginate returns a sequence of the items of a cursor-based API, fetching pages lazily as the sequence is iterated.
+//
+// The first page is fetched with the zero value of Cursor, and each following page with the cursor returned by the
+// previous call to fetch. The sequence ends after a page whose next cursor is the zero value.
+//
+// If fetch returns an error, or ctx is done before a page is fetched, the sequence yields the error with
+// the zero value of T, and ends. Items of a page are yielded even if fetch also returns an error for it.
+func Paginate[T any, Cursor comparable](ctx context.Context, fetch func(ctx context.Context, cursor Cursor) (items []T, next Cursor, err error)) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var (
+			cursor Cursor
+			zero   T
+			none   Cursor
+		)
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			items, next, err := fetch(ctx, cursor)
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			if next == none {
+				return
+			}
+			cursor = next
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package iterx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+// pages is a fake API returning the pages, where each cursor is the index of the page.
+func pages(p [][]string, failAt int) func(ctx context.Context, cursor int) ([]string, int, error) {
+	return func(_ context.Context, cursor int) ([]string, int, error) {
+		if cursor == failAt {
+			return nil, 0, errors.New("fetch failed")
+		}
+		next := cursor + 1
+		if next == len(p) {
+			next = 0
+		}
+		return p[cursor], next, nil
+	}
+}
+
+func ExamplePaginate() {
+	fetch := pages([][]string{{"a", "b"}, {"c"}, {"d"}}, -1)
+	for item, err := range Paginate(context.Background(), fetch) {
+		if err != nil {
+			fmt.Println("error:", err)
+			break
+		}
+		fmt.Println(item)
+	}
+	// Output:
+	// a
+	// b
+	// c
+	// d
+}
+
+func TestPaginate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		failAt    int
+		take      int
+		expect    []string
+		expectErr bool
+		fetches   int
+	}{
+		{name: "all", ctx: context.Background(), failAt: -1, take: 10, expect: []string{"a", "b", "c", "d"}, fetches: 3},
+		{name: "error", ctx: context.Background(), failAt: 1, take: 10, expect: []string{"a", "b"}, expectErr: true, fetches: 2},
+		{name: "lazy", ctx: context.Background(), failAt: -1, take: 2, expect: []string{"a", "b"}, fetches: 1},
+		{name: "cancelled", ctx: ctx, failAt: -1, take: 10, expectErr: true, fetches: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch := pages([][]string{{"a", "b"}, {"c"}, {"d"}}, tt.failAt)
+			var fetches int
+			counted := func(ctx context.Context, cursor int) ([]string, int, error) {
+				fetches++
+				return fetch(ctx, cursor)
+			}
+			var got []string
+			var gotErr error
+			for item, err := range Paginate(tt.ctx, counted) {
+				if err != nil {
+					gotErr = err
+					continue
+				}
+				got = append(got, item)
+				if len(got) == tt.take {
+					break
+				}
+			}
+			if !slices.Equal(got, tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+			if (gotErr != nil) != tt.expectErr {
+				t.Errorf("expected error=%v, got=%v", tt.expectErr, gotErr)
+			}
+			if fetches != tt.fetches {
+				t.Errorf("expected=%v fetches, got=%v", tt.fetches, fetches)
+			}
+		})
+	}
+}
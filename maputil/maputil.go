@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package maputil contains map transformations which are not covered by the standard maps package.
+package maputil
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+)
+
+// Merge copies the entries of each of srcs into dst, in order, and returns dst. If dst is nil, a new map is created.
+// When a key is already in dst, conflict is called with the key, the existing value and the new value,
+// and its result is stored. If conflict is nil, the new value replaces the existing one.
+func Merge[M ~map[K]V, K comparable, V any](dst M, conflict func(key K, existing, incoming V) V, srcs ...M) M {
+	if dst == nil {
+		dst = make(M)
+	}
+	for _, src := range srcs {
+		for k, v := range src {
+			if existing, ok := dst[k]; ok && conflict != nil {
+				v = conflict(k, existing, v)
+			}
+			dst[k] = v
+		}
+	}
+	return dst
+}
+
+// Invert returns a map from the values of m to their keys. If several keys have the same value,
+// which of them is kept is unspecified.
+func Invert[M ~map[K]V, K, V comparable](m M) map[V]K {
+	inv := make(map[V]K, len(m))
+	for k, v := range m {
+		inv[v] = k
+	}
+	return inv
+}
+
+// Filter returns a new map with the entries of m for which pred returns true.
+func Filter[M ~map[K]V, K comparable, V any](m M, pred func(k K, v V) bool) M {
+	out := make(M)
+	for k, v := range m {
+		if pred(k, v) {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// FilterKeys returns a new map with the entries of m whose keys satisfy pred.
+func FilterKeys[M ~map[K]V, K comparable, V any](m M, pred func(k K) bool) M {
+	return Filter(m, func(k K, _ V) bool { return pred(k) })
+}
+
+// FilterValues returns a new map with the entries of m whose values satisfy pred.
+func FilterValues[M ~map[K]V, K comparable, V any](m M, pred func(v V) bool) M {
+	return Filter(m, func(_ K, v V) bool { return pred(v) })
+}
+
+// MapValues returns a new map with the keys of m, and the results of applying fn to its values.
+func MapValues[M ~map[K]V, K comparable, V, W any](m M, fn func(v V) W) map[K]W {
+	out := make(map[K]W, len(m))
+	for k, v := range m {
+		out[k] = fn(v)
+	}
+	return out
+}
+
+// SortedKeys returns the keys of m in ascending order.
+func SortedKeys[M ~map[K]V, K cmp.Ordered, V any](m M) []K {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package maputil
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func ExampleMerge() {
+	defaults := map[string]int{"retries": 3, "timeout": 10}
+	overrides := map[string]int{"timeout": 30}
+	merged := Merge(nil, nil, defaults, overrides)
+	for _, k := range SortedKeys(merged) {
+		fmt.Println(k, merged[k])
+	}
+	// Output:
+	// retries 3
+	// timeout 30
+}
+
+func TestMerge(t *testing.T) {
+	dst := map[string]int{"a": 1}
+	sum := func(_ string, a, b int) int { return a + b }
+	got := Merge(dst, sum, map[string]int{"a": 2, "b": 3}, map[string]int{"b": 4})
+	if expect := map[string]int{"a": 3, "b": 7}; !maps.Equal(got, expect) || !maps.Equal(dst, expect) {
+		t.Errorf("expected=%v, got=%v", expect, got)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	got := Invert(map[string]int{"one": 1, "two": 2})
+	if expect := map[int]string{1: "one", 2: "two"}; !maps.Equal(got, expect) {
+		t.Errorf("expected=%v, got=%v", expect, got)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	m := map[string]int{"apple": 1, "banana": 2, "avocado": 3}
+	tests := []struct {
+		name   string
+		got    map[string]int
+		expect map[string]int
+	}{
+		{name: "filter", got: Filter(m, func(k string, v int) bool { return k != "banana" && v > 1 }), expect: map[string]int{"avocado": 3}},
+		{name: "keys", got: FilterKeys(m, func(k string) bool { return strings.HasPrefix(k, "a") }), expect: map[string]int{"apple": 1, "avocado": 3}},
+		{name: "values", got: FilterValues(m, func(v int) bool { return v%2 == 0 }), expect: map[string]int{"banana": 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !maps.Equal(tt.got, tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, tt.got)
+			}
+		})
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	got := MapValues(map[string]int{"a": 1, "b": 2}, func(v int) string { return strings.Repeat("x", v) })
+	if expect := map[string]string{"a": "x", "b": "xx"}; !maps.Equal(got, expect) {
+		t.Errorf("expected=%v, got=%v", expect, got)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	if got := SortedKeys(map[int]bool{3: true, 1: true, 2: false}); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("expected=[1 2 3], got=%v", got)
+	}
+}
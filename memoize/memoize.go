@@ -0,0 +1,179 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package memoize caches the results of functions, so that an expensive function is called once per argument.
+package memoize
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/justenwalker/got/cache"
+	"github.com/justenwalker/got/cache/lru"
+	"github.com/justenwalker/got/clock"
+)
+
+// Func returns a function which calls fn once for each distinct argument, and returns the same result afterwards.
+// Results are kept forever, so fn should have a small set of arguments.
+// Concurrent calls with the same argument wait for a single call to fn. The returned function is safe for concurrent use.
+// If fn panics, the panic is memoized as well: every call with the same argument panics with the same value.
+func Func[K comparable, V any](fn func(K) V) func(K) V {
+	var mu sync.Mutex
+	results := make(map[K]*result[V])
+	return func(key K) V {
+		mu.Lock()
+		r, ok := results[key]
+		if !ok {
+			r = &result[V]{}
+			results[key] = r
+		}
+		mu.Unlock()
+		r.once.Do(func() {
+			// since Go 1.21, recover returns a non-nil value whenever fn panics, even for panic(nil).
+			defer func() { r.panicked = recover() }()
+			r.value = fn(key)
+		})
+		if r.panicked != nil {
+			panic(r.panicked)
+		}
+		return r.value
+	}
+}
+
+type result[V any] struct {
+	once     sync.Once
+	value    V
+	panicked any
+}
+
+// Option configures a function memoized with FuncContext.
+type Option func(*options)
+
+type options struct {
+	ttl      time.Duration
+	errorTTL time.Duration
+	maxSize  int
+	clock    clock.Clock
+}
+
+// WithTTL makes memoized results expire after ttl, so that fn is called again for the same argument.
+// By default, results do not expire.
+func WithTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.ttl = ttl
+	}
+}
+
+// WithMaxSize limits the number of memoized results to n, evicting the least recently used result when it is full.
+// By default, the number of results is not limited.
+func WithMaxSize(n int) Option {
+	return func(o *options) {
+		o.maxSize = n
+	}
+}
+
+// WithErrorTTL makes errors returned by fn memoized for ttl, instead of calling fn again on the next call.
+// By default, errors are not memoized.
+func WithErrorTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.errorTTL = ttl
+	}
+}
+
+// WithClock sets the clock used to expire memoized results and errors. By default, it is clock.Real.
+func WithClock(clk clock.Clock) Option {
+	return func(o *options) {
+		o.clock = clk
+	}
+}
+
+// FuncContext returns a function which memoizes the results of fn, like Func.
+// Errors are returned to the caller and are not memoized unless WithErrorTTL is used.
+// Concurrent calls with the same argument wait for a single call to fn, which receives the context of the first caller.
+// The returned function is safe for concurrent use.
+func FuncContext[K comparable, V any](fn func(ctx context.Context, key K) (V, error), opts ...Option) func(ctx context.Context, key K) (V, error) {
+	o := options{clock: clock.Real()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var store cache.Cache[K, V]
+	if o.maxSize > 0 {
+		store = lru.NewConcurrent(o.maxSize, lru.WithTTL[K, V](o.ttl), lru.WithClock[K, V](o.clock))
+	} else {
+		store = &unbounded[K, V]{ttl: o.ttl, now: o.clock.Now, entries: make(map[K]entry[V])}
+	}
+	loading := cache.NewLoading(store, fn, cache.WithNegativeTTL[K, V](o.errorTTL), cache.WithLoadClock[K, V](o.clock))
+	return loading.Get
+}
+
+// unbounded is a cache.Cache without a size limit, whose entries may expire.
+type unbounded[K comparable, V any] struct {
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	entries map[K]entry[V]
+}
+
+type entry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+func (u *unbounded[K, V]) Get(key K) (V, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	e, ok := u.entries[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	if !e.expires.IsZero() && u.now().After(e.expires) {
+		delete(u.entries, key)
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+func (u *unbounded[K, V]) Put(key K, value V) {
+	e := entry[V]{value: value}
+	if u.ttl > 0 {
+		e.expires = u.now().Add(u.ttl)
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.entries[key] = e
+}
+
+func (u *unbounded[K, V]) Remove(key K) bool {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	_, ok := u.entries[key]
+	delete(u.entries, key)
+	return ok
+}
+
+func (u *unbounded[K, V]) Len() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return len(u.entries)
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package memoize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/clock"
+)
+
+func ExampleFunc() {
+	square := Func(func(n int) int {
+		fmt.Println("computing", n)
+		return n * n
+	})
+	fmt.Println(square(3))
+	fmt.Println(square(3))
+	// Output:
+	// computing 3
+	// 9
+	// 9
+}
+
+func TestFunc_concurrent(t *testing.T) {
+	var calls atomic.Int32
+	fn := Func(func(n int) int {
+		calls.Add(1)
+		return n * 2
+	})
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 10 {
+				if got := fn(i); got != i*2 {
+					t.Errorf("expected=%v, got=%v", i*2, got)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if n := calls.Load(); n != 10 {
+		t.Errorf("expected=10 calls, got=%v", n)
+	}
+}
+
+func TestFunc_panic(t *testing.T) {
+	var calls int
+	fn := Func(func(n int) int {
+		calls++
+		panic(fmt.Sprint("boom ", n))
+	})
+	call := func() (v any) {
+		defer func() { v = recover() }()
+		fn(1)
+		return nil
+	}
+	for range 2 {
+		if got := call(); got != "boom 1" {
+			t.Errorf("expected=%v, got=%v", "boom 1", got)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("calls expected=%v, got=%v", 1, calls)
+	}
+}
+
+func TestFuncContext(t *testing.T) {
+	errOdd := errors.New("odd")
+	tests := []struct {
+		name      string
+		opts      []Option
+		wait      time.Duration
+		keys      []int
+		expCalls  int
+		expErrors int
+	}{
+		{name: "memoized", keys: []int{2, 2, 4, 2}, expCalls: 2},
+		{name: "errors not memoized", keys: []int{1, 1}, expCalls: 2, expErrors: 2},
+		{name: "errors memoized", opts: []Option{WithErrorTTL(time.Hour)}, keys: []int{1, 1}, expCalls: 1, expErrors: 2},
+		{name: "max size", opts: []Option{WithMaxSize(1)}, keys: []int{2, 4, 2}, expCalls: 3},
+		{name: "ttl", opts: []Option{WithTTL(time.Minute)}, wait: time.Minute + 1, keys: []int{2, 2}, expCalls: 2},
+		{name: "ttl not expired", opts: []Option{WithTTL(time.Minute)}, wait: time.Minute, keys: []int{2, 2}, expCalls: 1},
+		{name: "ttl with max size", opts: []Option{WithTTL(time.Minute), WithMaxSize(10)}, wait: time.Minute + 1, keys: []int{2, 2}, expCalls: 2},
+		{name: "ttl not expired with max size", opts: []Option{WithTTL(time.Minute), WithMaxSize(10)}, wait: time.Minute, keys: []int{2, 2}, expCalls: 1},
+		{name: "error ttl", opts: []Option{WithErrorTTL(time.Minute)}, wait: time.Minute + 1, keys: []int{1, 1}, expCalls: 2, expErrors: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls, errs int
+			clk := clock.NewFake(time.Unix(0, 0))
+			fn := FuncContext(func(_ context.Context, n int) (int, error) {
+				calls++
+				if n%2 == 1 {
+					return 0, errOdd
+				}
+				return n / 2, nil
+			}, append(tt.opts, WithClock(clk))...)
+			for _, k := range tt.keys {
+				v, err := fn(context.Background(), k)
+				switch {
+				case err != nil:
+					errs++
+				case v != k/2:
+					t.Errorf("expected=%v, got=%v", k/2, v)
+				}
+				clk.Advance(tt.wait)
+			}
+			if calls != tt.expCalls {
+				t.Errorf("expected=%v calls, got=%v", tt.expCalls, calls)
+			}
+			if errs != tt.expErrors {
+				t.Errorf("expected=%v errors, got=%v", tt.expErrors, errs)
+			}
+		})
+	}
+}
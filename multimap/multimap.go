@@ -0,0 +1,131 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package multimap contains a map type which associates each key with multiple values.
+package multimap
+
+import (
+	"iter"
+	"maps"
+	"slices"
+)
+
+// Multimap is a map from each key to a list of values.
+// It takes care of the bookkeeping of a map[K][]V: values are appended to a key's list,
+// and keys are removed when their last value is removed, so a key is present only if it has values.
+//
+// A nil Multimap is empty, but must be created with New or make before adding values.
+type Multimap[K comparable, V any] map[K][]V
+
+// New creates an empty Multimap.
+func New[K comparable, V any]() Multimap[K, V] {
+	return make(Multimap[K, V])
+}
+
+// Add appends values to the list of values for key.
+func (m Multimap[K, V]) Add(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	m[key] = append(m[key], values...)
+}
+
+// Get returns the values for key, in the order they were added. The returned slice must not be modified.
+func (m Multimap[K, V]) Get(key K) []V {
+	return m[key]
+}
+
+// Contains reports whether key has any values.
+func (m Multimap[K, V]) Contains(key K) bool {
+	return len(m[key]) > 0
+}
+
+// Delete removes key and all its values, and returns the values that were removed.
+func (m Multimap[K, V]) Delete(key K) []V {
+	values := m[key]
+	delete(m, key)
+	return values
+}
+
+// DeleteFunc removes the values of key for which del returns true, and returns the number of values removed.
+// If no values remain, key is removed.
+func (m Multimap[K, V]) DeleteFunc(key K, del func(v V) bool) int {
+	values, ok := m[key]
+	if !ok {
+		return 0
+	}
+	remaining := slices.DeleteFunc(values, del)
+	if len(remaining) == 0 {
+		delete(m, key)
+	} else {
+		m[key] = remaining
+	}
+	return len(values) - len(remaining)
+}
+
+// Len returns the total number of values in the Multimap.
+func (m Multimap[K, V]) Len() int {
+	n := 0
+	for _, values := range m {
+		n += len(values)
+	}
+	return n
+}
+
+// Keys returns a sequence of the keys which have values, in no particular order.
+func (m Multimap[K, V]) Keys() iter.Seq[K] {
+	return maps.Keys(m)
+}
+
+// Values returns a sequence of all the values in the Multimap. Keys are visited in no particular order,
+// and the values of each key in the order they were added.
+func (m Multimap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, values := range m {
+			for _, v := range values {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// All returns a sequence of every key and value pair in the Multimap. A key is repeated for each of its values.
+func (m Multimap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, values := range m {
+			for _, v := range values {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Remove removes every occurrence of value from the values of key in m, and returns the number of values removed.
+// If no values remain, key is removed.
+func Remove[K comparable, V comparable](m Multimap[K, V], key K, value V) int {
+	return m.DeleteFunc(key, func(v V) bool {
+		return v == value
+	})
+}
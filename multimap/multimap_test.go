@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package multimap
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func ExampleMultimap() {
+	headers := New[string, string]()
+	headers.Add("Accept", "text/html", "application/json")
+	headers.Add("Cookie", "a=1")
+	fmt.Println(headers.Get("Accept"))
+	Remove(headers, "Cookie", "a=1")
+	fmt.Println(headers.Contains("Cookie"), len(headers))
+	// Output:
+	// [text/html application/json]
+	// false 1
+}
+
+func TestMultimap(t *testing.T) {
+	m := New[string, int]()
+	m.Add("a", 1, 2)
+	m.Add("a", 3)
+	m.Add("b", 4)
+	m.Add("c")
+	if _, ok := m["c"]; ok {
+		t.Errorf("expected Add with no values not to create a key")
+	}
+	if actual := m.Get("a"); !slices.Equal(actual, []int{1, 2, 3}) {
+		t.Errorf("expected=[1 2 3], got=%v", actual)
+	}
+	if m.Len() != 4 || !m.Contains("b") || m.Contains("c") {
+		t.Errorf("unexpected contents: %v", m)
+	}
+	if actual := m.Delete("b"); !slices.Equal(actual, []int{4}) || m.Contains("b") {
+		t.Errorf("expected Delete to remove [4], got=%v", actual)
+	}
+	if n := m.DeleteFunc("a", func(v int) bool { return v%2 == 1 }); n != 2 {
+		t.Errorf("expected=2, got=%v", n)
+	}
+	if actual := m.Get("a"); !slices.Equal(actual, []int{2}) {
+		t.Errorf("expected=[2], got=%v", actual)
+	}
+	if n := Remove(m, "a", 2); n != 1 || m.Contains("a") || len(m) != 0 {
+		t.Errorf("expected key to be removed with its last value, got=%v", m)
+	}
+	if n := m.DeleteFunc("missing", func(int) bool { return true }); n != 0 {
+		t.Errorf("expected=0, got=%v", n)
+	}
+}
+
+func TestMultimap_iteration(t *testing.T) {
+	m := New[string, int]()
+	m.Add("a", 1, 2)
+	m.Add("b", 3)
+	keys := slices.Sorted(m.Keys())
+	if !slices.Equal(keys, []string{"a", "b"}) {
+		t.Errorf("expected=[a b], got=%v", keys)
+	}
+	values := slices.Sorted(m.Values())
+	if !slices.Equal(values, []int{1, 2, 3}) {
+		t.Errorf("expected=[1 2 3], got=%v", values)
+	}
+	var pairs []string
+	for k, v := range m.All() {
+		pairs = append(pairs, fmt.Sprintf("%s=%d", k, v))
+	}
+	slices.Sort(pairs)
+	if !slices.Equal(pairs, []string{"a=1", "a=2", "b=3"}) {
+		t.Errorf("expected=[a=1 a=2 b=3], got=%v", pairs)
+	}
+	for range m.All() {
+		break
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package optional
+
+import (
+	"bytes"
+	"sync/atomic"
+
+	"github.com/justenwalker/got/fault"
+)
+
+// Codec marshals and unmarshals values for a binary encoding, such as CBOR or MessagePack.
+// The functions of the encoding's library can be used directly, for example:
+//
+//	optional.RegisterCBOR(optional.Codec{Marshal: cbor.Marshal, Unmarshal: cbor.Unmarshal})
+//	optional.RegisterMsgpack(optional.Codec{Marshal: msgpack.Marshal, Unmarshal: msgpack.Unmarshal})
+type Codec struct {
+	Marshal   func(v any) ([]byte, error)
+	Unmarshal func(data []byte, v any) error
+}
+
+// ErrNoCodec is returned when marshaling or unmarshaling a valid Value for an encoding without a registered Codec.
+const ErrNoCodec = fault.Message("optional: no codec registered")
+
+var (
+	cborCodec    atomic.Pointer[Codec]
+	msgpackCodec atomic.Pointer[Codec]
+
+	cborNull      = []byte{0xf6}
+	cborUndefined = []byte{0xf7}
+	msgpackNil    = []byte{0xc0}
+)
+
+// RegisterCBOR registers the Codec used to marshal and unmarshal the wrapped values of Values as CBOR.
+//
+// Value implements the Marshaler and Unmarshaler interfaces used by CBOR libraries such as github.com/fxamacker/cbor,
+// so that, as with JSON, a valid Value encodes as its wrapped value, and an invalid Value encodes as CBOR null.
+// The wrapped value is encoded by the registered Codec, so that this package doesn't depend on a CBOR library.
+func RegisterCBOR(c Codec) {
+	cborCodec.Store(&c)
+}
+
+// RegisterMsgpack registers the Codec used to marshal and unmarshal the wrapped values of Values as MessagePack.
+//
+// Value implements the Marshaler and Unmarshaler interfaces used by MessagePack libraries such as
+// github.com/vmihailenco/msgpack, so that, as with JSON, a valid Value encodes as its wrapped value,
+// and an invalid Value encodes as MessagePack nil.
+// The wrapped value is encoded by the registered Codec, so that this package doesn't depend on a MessagePack library.
+func RegisterMsgpack(c Codec) {
+	msgpackCodec.Store(&c)
+}
+
+// MarshalCBOR marshals the wrapped value of type T to CBOR, using the Codec registered with RegisterCBOR.
+// If the value is not valid, it returns CBOR null.
+func (v Value[T]) MarshalCBOR() ([]byte, error) {
+	return v.marshalBinary(&cborCodec, cborNull)
+}
+
+// UnmarshalCBOR unmarshals the CBOR data into the Value of type T, using the Codec registered with RegisterCBOR.
+// If the CBOR data is null or undefined, the Value is Nothing.
+func (v *Value[T]) UnmarshalCBOR(data []byte) error {
+	if bytes.Equal(data, cborUndefined) {
+		*v = Nothing[T]()
+		return nil
+	}
+	return v.unmarshalBinary(&cborCodec, cborNull, data)
+}
+
+// MarshalMsgpack marshals the wrapped value of type T to MessagePack, using the Codec registered with RegisterMsgpack.
+// If the value is not valid, it returns MessagePack nil.
+func (v Value[T]) MarshalMsgpack() ([]byte, error) {
+	return v.marshalBinary(&msgpackCodec, msgpackNil)
+}
+
+// UnmarshalMsgpack unmarshals the MessagePack data into the Value of type T, using the Codec registered with RegisterMsgpack.
+// If the MessagePack data is nil, the Value is Nothing.
+func (v *Value[T]) UnmarshalMsgpack(data []byte) error {
+	return v.unmarshalBinary(&msgpackCodec, msgpackNil, data)
+}
+
+func (v Value[T]) marshalBinary(codec *atomic.Pointer[Codec], null []byte) ([]byte, error) {
+	if !v.IsValid() {
+		return bytes.Clone(null), nil
+	}
+	c := codec.Load()
+	if c == nil {
+		return nil, ErrNoCodec
+	}
+	return c.Marshal(v.Wrapped)
+}
+
+func (v *Value[T]) unmarshalBinary(codec *atomic.Pointer[Codec], null []byte, data []byte) error {
+	if bytes.Equal(data, null) {
+		*v = Nothing[T]()
+		return nil
+	}
+	c := codec.Load()
+	if c == nil {
+		return ErrNoCodec
+	}
+	var t T
+	if err := c.Unmarshal(data, &t); err != nil {
+		return err
+	}
+	*v = Value[T]{Wrapped: t, Valid: true}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package optional
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// jsonCodec stands in for a binary encoding library.
+var jsonCodec = Codec{Marshal: json.Marshal, Unmarshal: json.Unmarshal}
+
+func TestValue_binary(t *testing.T) {
+	tests := []struct {
+		name      string
+		register  func(Codec)
+		marshal   func(v Value[int]) ([]byte, error)
+		unmarshal func(v *Value[int], data []byte) error
+		null      []byte
+	}{
+		{
+			name:      "cbor",
+			register:  RegisterCBOR,
+			marshal:   Value[int].MarshalCBOR,
+			unmarshal: (*Value[int]).UnmarshalCBOR,
+			null:      []byte{0xf6},
+		},
+		{
+			name:      "msgpack",
+			register:  RegisterMsgpack,
+			marshal:   Value[int].MarshalMsgpack,
+			unmarshal: (*Value[int]).UnmarshalMsgpack,
+			null:      []byte{0xc0},
+		},
+	}
+	defer cborCodec.Store(nil)
+	defer msgpackCodec.Store(nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.marshal(New(1)); !errors.Is(err, ErrNoCodec) {
+				t.Errorf("expected=%v, got=%v", ErrNoCodec, err)
+			}
+			if err := tt.unmarshal(new(Value[int]), []byte("1")); !errors.Is(err, ErrNoCodec) {
+				t.Errorf("expected=%v, got=%v", ErrNoCodec, err)
+			}
+			tt.register(jsonCodec)
+
+			data, err := tt.marshal(Nothing[int]())
+			if err != nil || !bytes.Equal(data, tt.null) {
+				t.Errorf("expected=%x, got=%x (%v)", tt.null, data, err)
+			}
+			data, err = tt.marshal(New(42))
+			if err != nil || string(data) != "42" {
+				t.Errorf("expected=42, got=%s (%v)", data, err)
+			}
+
+			v := New(1)
+			if err = tt.unmarshal(&v, tt.null); err != nil || v != Nothing[int]() {
+				t.Errorf("expected=%v, got=%v (%v)", Nothing[int](), v, err)
+			}
+			if err = tt.unmarshal(&v, []byte("42")); err != nil || v != New(42) {
+				t.Errorf("expected=%v, got=%v (%v)", New(42), v, err)
+			}
+			if err = tt.unmarshal(&v, []byte(`"x"`)); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+
+	v := New(1)
+	if err := v.UnmarshalCBOR([]byte{0xf7}); err != nil || v != Nothing[int]() {
+		t.Errorf("expected=%v, got=%v (%v)", Nothing[int](), v, err)
+	}
+}
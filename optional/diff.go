@@ -0,0 +1,124 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package optional
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// optionalValue is implemented by every Value, so that Values can be recognized using reflection.
+type optionalValue interface {
+	valueOf() (any, bool)
+}
+
+func (v Value[T]) valueOf() (any, bool) {
+	return v.Wrapped, v.Valid
+}
+
+var optionalValueType = reflect.TypeFor[optionalValue]()
+
+// Diff compares two structs of the same type, whose fields are Value or *Value, and returns the fields which changed.
+// The result maps the name of each changed field to its Value in newValue, so that it can be used as an audit record,
+// or marshaled to JSON as the payload of a minimal PATCH request; a field which was unset marshals to null.
+//
+// Fields are named according to their `json` tag, or their Go name if it doesn't have one, and fields tagged `json:"-"`
+// are ignored, as are unexported fields and fields which are not Values. A nil *Value is the same as Nothing,
+// and two Values are the same if both are unset, or both are set to deeply equal values.
+//
+// oldValue and newValue may be structs or pointers to structs. Diff returns an error if they aren't,
+// or if their types are different.
+func Diff(oldValue, newValue any) (map[string]any, error) {
+	ov, nv := reflect.Indirect(reflect.ValueOf(oldValue)), reflect.Indirect(reflect.ValueOf(newValue))
+	if ov.Kind() != reflect.Struct || nv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("optional: cannot diff %T and %T: not structs", oldValue, newValue)
+	}
+	if ov.Type() != nv.Type() {
+		return nil, fmt.Errorf("optional: cannot diff %T and %T: different types", oldValue, newValue)
+	}
+	changes := make(map[string]any)
+	for _, f := range reflect.VisibleFields(ov.Type()) {
+		name := fieldName(f)
+		if !f.IsExported() || f.Anonymous || name == "" || !isOptional(f.Type) {
+			continue
+		}
+		// fields promoted through a nil embedded pointer are unset.
+		of, _ := ov.FieldByIndexErr(f.Index)
+		nf, _ := nv.FieldByIndexErr(f.Index)
+		if of.IsValid() && !of.CanInterface() || nf.IsValid() && !nf.CanInterface() {
+			continue
+		}
+		if equalOptional(of, nf) {
+			continue
+		}
+		if !nf.IsValid() {
+			nf = reflect.Zero(f.Type)
+		}
+		changes[name] = nf.Interface()
+	}
+	return changes, nil
+}
+
+// isOptional reports whether t is a Value or *Value.
+func isOptional(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t.Implements(optionalValueType)
+}
+
+func fieldName(f reflect.StructField) string {
+	tag, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+	switch tag {
+	case "-":
+		return ""
+	case "":
+		return f.Name
+	default:
+		return tag
+	}
+}
+
+// unwrapOptional returns the wrapped value of a Value or *Value field v.
+// An invalid reflect.Value, such as a field of a nil embedded pointer, is unset.
+func unwrapOptional(v reflect.Value) (any, bool) {
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, false
+		}
+		v = v.Elem()
+	}
+	if !v.IsValid() {
+		return nil, false
+	}
+	return v.Interface().(optionalValue).valueOf()
+}
+
+func equalOptional(a, b reflect.Value) bool {
+	av, aok := unwrapOptional(a)
+	bv, bok := unwrapOptional(b)
+	if aok != bok {
+		return false
+	}
+	return !aok || reflect.DeepEqual(av, bv)
+}
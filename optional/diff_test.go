@@ -0,0 +1,156 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type diffAudit struct {
+	CreatedBy Value[string] `json:"createdBy"`
+}
+
+type diffUser struct {
+	*diffAudit
+	Name     Value[string]   `json:"name,omitempty"`
+	Age      *Value[int]     `json:"age,omitempty"`
+	Tags     Value[[]string] `json:"tags"`
+	Internal Value[string]   `json:"-"`
+	Email    Value[string]
+	Plain    string
+	private  Value[string]
+	Extra    Value[map[string]int] `json:"extra"`
+}
+
+func ExampleDiff() {
+	type User struct {
+		Name  Value[string] `json:"name"`
+		Email Value[string] `json:"email"`
+		Age   Value[int]    `json:"age"`
+	}
+	before := User{Name: New("Alice"), Email: New("alice@example.com"), Age: New(30)}
+	after := User{Name: New("Alice"), Age: New(31)}
+	changes, _ := Diff(before, after)
+	patch, _ := json.Marshal(changes)
+	fmt.Println(string(patch))
+	// Output: {"age":31,"email":null}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		old    any
+		new    any
+		expect map[string]any
+	}{
+		{
+			name:   "unchanged",
+			old:    diffUser{Name: New("a"), Tags: New([]string{"x"}), Plain: "p"},
+			new:    &diffUser{Name: New("a"), Tags: New([]string{"x"}), Plain: "q"},
+			expect: map[string]any{},
+		},
+		{
+			name:   "unset values are equal",
+			old:    diffUser{Name: Value[string]{Wrapped: "a"}, Age: &Value[int]{}},
+			new:    diffUser{},
+			expect: map[string]any{},
+		},
+		{
+			name: "changed",
+			old:  diffUser{Name: New("a"), Age: New(1).Ptr(), Tags: New([]string{"x"}), Email: New("e")},
+			new:  diffUser{Name: New("b"), Age: New(1).Ptr(), Tags: New([]string{"y"})},
+			expect: map[string]any{
+				"name":  New("b"),
+				"tags":  New([]string{"y"}),
+				"Email": Nothing[string](),
+			},
+		},
+		{
+			name: "pointers",
+			old:  diffUser{Age: New(1).Ptr()},
+			new:  diffUser{Extra: New(map[string]int{"a": 1})},
+			expect: map[string]any{
+				"age":   (*Value[int])(nil),
+				"extra": New(map[string]int{"a": 1}),
+			},
+		},
+		{
+			name:   "ignored fields",
+			old:    diffUser{Internal: New("a"), private: New("a")},
+			new:    diffUser{Internal: New("b")},
+			expect: map[string]any{},
+		},
+		{
+			name: "embedded",
+			old:  diffUser{},
+			new:  diffUser{diffAudit: &diffAudit{CreatedBy: New("bob")}},
+			expect: map[string]any{
+				"createdBy": New("bob"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Diff(tt.old, tt.new)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestDiff_error(t *testing.T) {
+	tests := []struct {
+		name string
+		old  any
+		new  any
+	}{
+		{
+			name: "not structs",
+			old:  1,
+			new:  2,
+		},
+		{
+			name: "nil",
+			old:  nil,
+			new:  diffUser{},
+		},
+		{
+			name: "different types",
+			old:  diffUser{},
+			new:  diffAudit{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Diff(tt.old, tt.new); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
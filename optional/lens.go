@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package optional
+
+// Lens gets and sets an optional field of a struct of type S, so that code can work with fields
+// generically, such as utilities which merge or compare configuration structs.
+// Lenses for nested fields are made by composing lenses with Compose.
+//
+// A Lens must be created with NewLens.
+type Lens[S, T any] struct {
+	get func(S) Value[T]
+	set func(*S, Value[T])
+}
+
+// NewLens creates a Lens from functions which get and set the field.
+//
+//	type Config struct {
+//	    Port Value[int]
+//	}
+//
+//	port := optional.NewLens(
+//	    func(c Config) optional.Value[int] { return c.Port },
+//	    func(c *Config, v optional.Value[int]) { c.Port = v },
+//	)
+func NewLens[S, T any](get func(S) Value[T], set func(*S, Value[T])) Lens[S, T] {
+	return Lens[S, T]{get: get, set: set}
+}
+
+// Get returns the field of s.
+func (l Lens[S, T]) Get(s S) Value[T] {
+	return l.get(s)
+}
+
+// Set sets the field of s to v.
+func (l Lens[S, T]) Set(s *S, v Value[T]) {
+	l.set(s, v)
+}
+
+// Update sets the field of s to the result of calling fn with its current value.
+func (l Lens[S, T]) Update(s *S, fn func(v Value[T]) Value[T]) {
+	l.set(s, fn(l.get(*s)))
+}
+
+// Merge sets the field of dst to the field of src, if it is valid.
+func (l Lens[S, T]) Merge(dst *S, src S) {
+	if v := l.get(src); v.IsValid() {
+		l.set(dst, v)
+	}
+}
+
+// Compose combines a Lens for an optional field of type B in A with a Lens for a field of type C in B,
+// to create a Lens for the nested field of type C in A.
+//
+// Getting the nested field returns Nothing if the outer field is not valid. Setting the nested field to a valid Value
+// sets the outer field, starting from the zero value of B if it was not valid; setting it to Nothing leaves an
+// invalid outer field unchanged.
+func Compose[A, B, C any](outer Lens[A, B], inner Lens[B, C]) Lens[A, C] {
+	return Lens[A, C]{
+		get: func(a A) Value[C] {
+			b := outer.get(a)
+			if !b.IsValid() {
+				return Nothing[C]()
+			}
+			return inner.get(b.Wrapped)
+		},
+		set: func(a *A, c Value[C]) {
+			b := outer.get(*a)
+			if !b.IsValid() {
+				if !c.IsValid() {
+					return
+				}
+				b = New(b.Wrapped)
+			}
+			inner.set(&b.Wrapped, c)
+			outer.set(a, b)
+		},
+	}
+}
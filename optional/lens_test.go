@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package optional
+
+import (
+	"fmt"
+	"testing"
+)
+
+type lensServer struct {
+	Port Value[int]
+	Host Value[string]
+}
+
+type lensConfig struct {
+	Name   Value[string]
+	Server Value[lensServer]
+}
+
+var (
+	lensName = NewLens(
+		func(c lensConfig) Value[string] { return c.Name },
+		func(c *lensConfig, v Value[string]) { c.Name = v },
+	)
+	lensServerField = NewLens(
+		func(c lensConfig) Value[lensServer] { return c.Server },
+		func(c *lensConfig, v Value[lensServer]) { c.Server = v },
+	)
+	lensPort = NewLens(
+		func(s lensServer) Value[int] { return s.Port },
+		func(s *lensServer, v Value[int]) { s.Port = v },
+	)
+	lensServerPort = Compose(lensServerField, lensPort)
+)
+
+func ExampleCompose() {
+	type Server struct {
+		Port Value[int]
+	}
+	type Config struct {
+		Server Value[Server]
+	}
+	server := NewLens(
+		func(c Config) Value[Server] { return c.Server },
+		func(c *Config, v Value[Server]) { c.Server = v },
+	)
+	port := NewLens(
+		func(s Server) Value[int] { return s.Port },
+		func(s *Server, v Value[int]) { s.Port = v },
+	)
+	serverPort := Compose(server, port)
+
+	var cfg Config
+	fmt.Println(serverPort.Get(cfg).Valid)
+	serverPort.Set(&cfg, New(8080))
+	fmt.Println(serverPort.Get(cfg).Wrapped)
+	// Output:
+	// false
+	// 8080
+}
+
+func TestLens(t *testing.T) {
+	var cfg lensConfig
+	if got := lensName.Get(cfg); got != Nothing[string]() {
+		t.Errorf("expected=%v, got=%v", Nothing[string](), got)
+	}
+	lensName.Set(&cfg, New("a"))
+	if cfg.Name != New("a") {
+		t.Errorf("expected=%v, got=%v", New("a"), cfg.Name)
+	}
+	lensName.Update(&cfg, func(v Value[string]) Value[string] {
+		return Map(v, func(s string) string { return s + "b" })
+	})
+	if cfg.Name != New("ab") {
+		t.Errorf("expected=%v, got=%v", New("ab"), cfg.Name)
+	}
+}
+
+func TestLens_Merge(t *testing.T) {
+	tests := []struct {
+		name   string
+		dst    lensConfig
+		src    lensConfig
+		expect lensConfig
+	}{
+		{
+			name:   "valid",
+			dst:    lensConfig{Name: New("a")},
+			src:    lensConfig{Name: New("b")},
+			expect: lensConfig{Name: New("b")},
+		},
+		{
+			name:   "invalid",
+			dst:    lensConfig{Name: New("a")},
+			src:    lensConfig{},
+			expect: lensConfig{Name: New("a")},
+		},
+		{
+			name:   "nested",
+			dst:    lensConfig{Server: New(lensServer{Host: New("h")})},
+			src:    lensConfig{Server: New(lensServer{Port: New(1)})},
+			expect: lensConfig{Server: New(lensServer{Host: New("h"), Port: New(1)})},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lensName.Merge(&tt.dst, tt.src)
+			lensServerPort.Merge(&tt.dst, tt.src)
+			if tt.dst != tt.expect {
+				t.Errorf("expected=%+v, got=%+v", tt.expect, tt.dst)
+			}
+		})
+	}
+}
+
+func TestCompose(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    lensConfig
+		set    Value[int]
+		expect lensConfig
+	}{
+		{
+			name:   "set creates outer",
+			cfg:    lensConfig{},
+			set:    New(80),
+			expect: lensConfig{Server: New(lensServer{Port: New(80)})},
+		},
+		{
+			name:   "set keeps siblings",
+			cfg:    lensConfig{Server: New(lensServer{Host: New("h"), Port: New(1)})},
+			set:    New(80),
+			expect: lensConfig{Server: New(lensServer{Host: New("h"), Port: New(80)})},
+		},
+		{
+			name:   "unset",
+			cfg:    lensConfig{Server: New(lensServer{Port: New(1)})},
+			set:    Nothing[int](),
+			expect: lensConfig{Server: New(lensServer{})},
+		},
+		{
+			name:   "unset without outer",
+			cfg:    lensConfig{},
+			set:    Nothing[int](),
+			expect: lensConfig{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lensServerPort.Set(&tt.cfg, tt.set)
+			if tt.cfg != tt.expect {
+				t.Errorf("expected=%+v, got=%+v", tt.expect, tt.cfg)
+			}
+			if got := lensServerPort.Get(tt.cfg); got != tt.set {
+				t.Errorf("expected=%v, got=%v", tt.set, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package optional
+
+// MapValues returns a map of the valid values in m, dropping the keys of invalid values.
+// The input map m is not modified.
+func MapValues[M ~map[K]Value[V], K comparable, V any](m M) map[K]V {
+	result := make(map[K]V, len(m))
+	for k, v := range m {
+		if v.IsValid() {
+			result[k] = v.Wrapped
+		}
+	}
+	return result
+}
+
+// FromMapLookup looks up the key k in m, and returns its value if it is present, or Nothing[V]() if it is not.
+// Unlike indexing the map, it distinguishes a missing key from a key with the zero value.
+func FromMapLookup[M ~map[K]V, K comparable, V any](m M, k K) Value[V] {
+	v, ok := m[k]
+	return Value[V]{
+		Wrapped: v,
+		Valid:   ok,
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package optional
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestMapValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  map[string]Value[int]
+		expect map[string]int
+	}{
+		{
+			name:   "nil",
+			input:  nil,
+			expect: map[string]int{},
+		},
+		{
+			name: "drops invalid",
+			input: map[string]Value[int]{
+				"a": New(1),
+				"b": Nothing[int](),
+				"c": New(0),
+			},
+			expect: map[string]int{
+				"a": 1,
+				"c": 0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MapValues(tt.input); !maps.Equal(got, tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestFromMapLookup(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+	tests := []struct {
+		name   string
+		key    string
+		expect Value[int]
+	}{
+		{
+			name:   "present",
+			key:    "a",
+			expect: New(1),
+		},
+		{
+			name:   "zero",
+			key:    "zero",
+			expect: New(0),
+		},
+		{
+			name:   "missing",
+			key:    "b",
+			expect: Nothing[int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FromMapLookup(m, tt.key); got != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+		})
+	}
+}
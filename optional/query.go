@@ -0,0 +1,163 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package optional
+
+import (
+	"encoding"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var (
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	durationType        = reflect.TypeFor[time.Duration]()
+)
+
+// DecodeQuery populates the Value and *Value fields of the struct pointed to by dst from url.Values,
+// such as the query parameters of a URL or the fields of a submitted form:
+//
+//	type SearchParams struct {
+//	    Query Value[string]   `query:"q"`
+//	    Limit Value[int]      `query:"limit"`
+//	    Tags  Value[[]string] `query:"tag"`
+//	}
+//
+//	var params SearchParams
+//	err := optional.DecodeQuery(r.URL.Query(), &params)
+//
+// Parameters are named according to the `query` tag of each field, or the field's Go name if it doesn't have one,
+// and fields tagged `query:"-"` are ignored, as are unexported fields and fields which are not Values.
+//
+// A field is set to a valid Value if its parameter is present, even if it is empty, and is left unchanged if it is absent;
+// so `?q=` sets Query to New(""), while a query without q leaves it as Nothing.
+// The wrapped type may be a string, bool, integer, float, time.Duration, a type implementing encoding.TextUnmarshaler,
+// or a slice of these, which is populated from every value of the parameter. Other types use the first value.
+func DecodeQuery(values url.Values, dst any) error {
+	v := reflect.ValueOf(dst)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("optional: cannot decode query into %T: not a pointer to a struct", dst)
+	}
+	v = v.Elem()
+	for _, f := range reflect.VisibleFields(v.Type()) {
+		name := f.Tag.Get("query")
+		if name == "" {
+			name = f.Name
+		}
+		if !f.IsExported() || f.Anonymous || name == "-" || !isOptional(f.Type) {
+			continue
+		}
+		params, ok := values[name]
+		if !ok {
+			continue
+		}
+		// fields promoted through a nil embedded pointer are ignored.
+		field, err := v.FieldByIndexErr(f.Index)
+		if err != nil || !field.CanSet() {
+			continue
+		}
+		// decode into a new Value, so that the field is left unchanged if the parameter is invalid.
+		valueType := f.Type
+		if valueType.Kind() == reflect.Pointer {
+			valueType = valueType.Elem()
+		}
+		decoded := reflect.New(valueType)
+		if err = decodeParams(decoded.Elem().FieldByName("Wrapped"), params); err != nil {
+			return fmt.Errorf("optional: decoding query parameter %q: %w", name, err)
+		}
+		decoded.Elem().FieldByName("Valid").SetBool(true)
+		switch {
+		case field.Kind() != reflect.Pointer:
+			field.Set(decoded.Elem())
+		case field.IsNil():
+			field.Set(decoded)
+		default:
+			field.Elem().Set(decoded.Elem())
+		}
+	}
+	return nil
+}
+
+func decodeParams(v reflect.Value, params []string) error {
+	if v.Kind() == reflect.Slice && !reflect.PointerTo(v.Type()).Implements(textUnmarshalerType) {
+		s := reflect.MakeSlice(v.Type(), len(params), len(params))
+		for i, p := range params {
+			if err := decodeParam(s.Index(i), p); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+		return nil
+	}
+	var p string
+	if len(params) > 0 {
+		p = params[0]
+	}
+	return decodeParam(v, p)
+}
+
+func decodeParam(v reflect.Value, p string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(p))
+	}
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(p)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(p)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(p)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(p, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(p, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(p, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported type %v", v.Type())
+	}
+	return nil
+}
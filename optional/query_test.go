@@ -0,0 +1,180 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package optional
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type queryPage struct {
+	Page Value[int] `query:"page"`
+}
+
+type queryParams struct {
+	queryPage
+	Query    Value[string]  `query:"q"`
+	Limit    *Value[uint8]  `query:"limit"`
+	Exact    Value[bool]    `query:"exact"`
+	Score    Value[float64] `query:"score"`
+	Timeout  Value[time.Duration]
+	Tags     Value[[]string] `query:"tag"`
+	IDs      Value[[]int64]  `query:"id"`
+	IP       Value[net.IP]   `query:"ip"`
+	Ignored  Value[string]   `query:"-"`
+	Plain    string          `query:"plain"`
+	internal Value[string]
+}
+
+func ExampleDecodeQuery() {
+	type SearchParams struct {
+		Query Value[string] `query:"q"`
+		Limit Value[int]    `query:"limit"`
+	}
+	var params SearchParams
+	_ = DecodeQuery(url.Values{"q": {""}}, &params)
+	fmt.Printf("q=%v limit=%v\n", params.Query.Ptr() != nil, params.Limit.Ptr() != nil)
+	// Output: q=true limit=false
+}
+
+func TestDecodeQuery(t *testing.T) {
+	tests := []struct {
+		name   string
+		query  string
+		expect queryParams
+	}{
+		{
+			name:   "empty",
+			query:  "",
+			expect: queryParams{},
+		},
+		{
+			name:  "present but empty",
+			query: "q=&tag=",
+			expect: queryParams{
+				Query: New(""),
+				Tags:  New([]string{""}),
+			},
+		},
+		{
+			name:  "all",
+			query: "page=2&q=go&limit=10&exact=true&score=1.5&Timeout=1m&tag=a&tag=b&id=1&id=-2&ip=127.0.0.1&Ignored=x&plain=x&internal=x",
+			expect: queryParams{
+				queryPage: queryPage{Page: New(2)},
+				Query:     New("go"),
+				Limit:     New[uint8](10).Ptr(),
+				Exact:     New(true),
+				Score:     New(1.5),
+				Timeout:   New(time.Minute),
+				Tags:      New([]string{"a", "b"}),
+				IDs:       New([]int64{1, -2}),
+				IP:        New(net.ParseIP("127.0.0.1")),
+			},
+		},
+		{
+			name:  "first value",
+			query: "q=a&q=b",
+			expect: queryParams{
+				Query: New("a"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got queryParams
+			if err = DecodeQuery(values, &got); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expect) {
+				t.Errorf("expected=%+v, got=%+v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestDecodeQuery_error(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		dst   any
+	}{
+		{
+			name:  "not a pointer",
+			query: "q=a",
+			dst:   queryParams{},
+		},
+		{
+			name:  "not a struct",
+			query: "q=a",
+			dst:   new(string),
+		},
+		{
+			name:  "empty int",
+			query: "page=",
+			dst:   &queryParams{},
+		},
+		{
+			name:  "overflow",
+			query: "limit=256",
+			dst:   &queryParams{},
+		},
+		{
+			name:  "invalid bool",
+			query: "exact=maybe",
+			dst:   &queryParams{},
+		},
+		{
+			name:  "invalid slice element",
+			query: "id=1&id=x",
+			dst:   &queryParams{},
+		},
+		{
+			name:  "unsupported type",
+			query: "m=1",
+			dst: &struct {
+				M Value[map[string]int] `query:"m"`
+			}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := url.ParseQuery(tt.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if err = DecodeQuery(values, tt.dst); err == nil {
+				t.Errorf("expected an error")
+			}
+			if p, ok := tt.dst.(*queryParams); ok && !reflect.DeepEqual(*p, queryParams{}) {
+				t.Errorf("expected dst to be unchanged, got=%+v", *p)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@
 
 package optional
 
+import "cmp"
+
 // Coalesce takes multiple Value[T] as input and returns the first valid Value[T].
 // If all the Value[T] are invalid or there are no Value[T] provided, it returns Nothing[T]().
 func Coalesce[T any](vals ...Value[T]) Value[T] {
@@ -48,3 +50,44 @@ func MapSlice[A any, B any](values []Value[A], mapFn func(a A) B) []Value[B] {
 	}
 	return result
 }
+
+// Sum returns the sum of the valid values, skipping invalid ones.
+// If none of the values are valid, it returns Nothing[T]().
+func Sum[T ~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64](values []Value[T]) Value[T] {
+	var result Value[T]
+	for _, v := range values {
+		if v.IsValid() {
+			result.Wrapped += v.Wrapped
+			result.Valid = true
+		}
+	}
+	return result
+}
+
+// Min returns the smallest of the valid values, skipping invalid ones.
+// If none of the values are valid, it returns Nothing[T]().
+// As with the built-in min, if any of the valid values is a NaN, the result is a NaN.
+func Min[T cmp.Ordered](values []Value[T]) Value[T] {
+	return reduceValid(values, func(a, b T) T { return min(a, b) })
+}
+
+// Max returns the largest of the valid values, skipping invalid ones.
+// If none of the values are valid, it returns Nothing[T]().
+// As with the built-in max, if any of the valid values is a NaN, the result is a NaN.
+func Max[T cmp.Ordered](values []Value[T]) Value[T] {
+	return reduceValid(values, func(a, b T) T { return max(a, b) })
+}
+
+func reduceValid[T any](values []Value[T], fn func(a, b T) T) Value[T] {
+	var result Value[T]
+	for _, v := range values {
+		switch {
+		case !v.IsValid():
+		case result.Valid:
+			result.Wrapped = fn(result.Wrapped, v.Wrapped)
+		default:
+			result = v
+		}
+	}
+	return result
+}
@@ -21,7 +21,10 @@
 
 package optional
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestCoalesce(t *testing.T) {
 	tests := []struct {
@@ -151,3 +154,86 @@ func TestMapSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []Value[int]
+		expect Value[int]
+	}{
+		{
+			name:   "nil",
+			input:  nil,
+			expect: Nothing[int](),
+		},
+		{
+			name:   "all invalid",
+			input:  []Value[int]{Nothing[int](), Nothing[int]()},
+			expect: Nothing[int](),
+		},
+		{
+			name:   "zero",
+			input:  []Value[int]{Nothing[int](), New(0)},
+			expect: New(0),
+		},
+		{
+			name:   "skips invalid",
+			input:  []Value[int]{New(1), Nothing[int](), New(2), New(3)},
+			expect: New(6),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sum(tt.input); got != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []Value[float64]
+		expectMin Value[float64]
+		expectMax Value[float64]
+	}{
+		{
+			name:      "nil",
+			input:     nil,
+			expectMin: Nothing[float64](),
+			expectMax: Nothing[float64](),
+		},
+		{
+			name:      "all invalid",
+			input:     []Value[float64]{Nothing[float64]()},
+			expectMin: Nothing[float64](),
+			expectMax: Nothing[float64](),
+		},
+		{
+			name:      "one",
+			input:     []Value[float64]{Nothing[float64](), New(-1.5)},
+			expectMin: New(-1.5),
+			expectMax: New(-1.5),
+		},
+		{
+			name:      "skips invalid",
+			input:     []Value[float64]{New(2.0), Nothing[float64](), New(-3.0), {Wrapped: -10}, New(1.0)},
+			expectMin: New(-3.0),
+			expectMax: New(2.0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Min(tt.input); got != tt.expectMin {
+				t.Errorf("Min: expected=%v, got=%v", tt.expectMin, got)
+			}
+			if got := Max(tt.input); got != tt.expectMax {
+				t.Errorf("Max: expected=%v, got=%v", tt.expectMax, got)
+			}
+		})
+	}
+	if got := Max([]Value[float64]{New(1.0), New(math.NaN())}); !math.IsNaN(got.Wrapped) || !got.Valid {
+		t.Errorf("expected=NaN, got=%v", got)
+	}
+}
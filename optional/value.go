@@ -102,6 +102,58 @@ func (v *Value[T]) WithValue(fn func(val T)) {
 	}
 }
 
+// OrZero returns the wrapped value if it is valid, otherwise it returns the zero value of T.
+//
+// Unlike GetWithDefault, it takes no arguments and has a value receiver, so that it is convenient to call
+// from text/template and html/template, where the Value may not be addressable: {{ .Name.OrZero }}.
+func (v Value[T]) OrZero() T {
+	if v.Valid {
+		return v.Wrapped
+	}
+	var z T
+	return z
+}
+
+// DerefAny returns the wrapped value if it is valid, otherwise it returns nil.
+//
+// It has a value receiver, so that it is convenient to call from text/template and html/template,
+// where a nil result is false in conditionals: {{ with .Name.DerefAny }}Hello, {{ . }}{{ end }}.
+func (v Value[T]) DerefAny() any {
+	if v.Valid {
+		return v.Wrapped
+	}
+	return nil
+}
+
+// Replace sets the `Value` to the given value `t`, and returns the previous `Value`.
+// Unlike Take, Replace panics if the receiver is nil, since there is no `Value` in which to store `t`.
+func (v *Value[T]) Replace(t T) Value[T] {
+	prev := *v
+	*v = New(t)
+	return prev
+}
+
+// Take returns the current `Value`, and leaves Nothing in its place.
+// If the receiver is nil, it returns Nothing.
+func (v *Value[T]) Take() Value[T] {
+	if v == nil {
+		return Nothing[T]()
+	}
+	prev := *v
+	*v = Nothing[T]()
+	return prev
+}
+
+// Validate calls the provided validation function `fn` with the wrapped value if the `Value` is valid,
+// and returns its error. If the `Value` is not valid, Validate returns nil without calling `fn`,
+// so that optional fields are only validated when they are set.
+func (v *Value[T]) Validate(fn func(val T) error) error {
+	if v.IsValid() {
+		return fn(v.Wrapped)
+	}
+	return nil
+}
+
 // Map applies the given map function which maps type A -> B.
 // The function takes a wrapped value of type A and returns a new wrapped value of type B.
 // If a is not valid, it returns Nothing[B]()
@@ -21,7 +21,12 @@
 
 package optional
 
-import "testing"
+import (
+	"errors"
+	"strings"
+	"testing"
+	"text/template"
+)
 
 func TestValue(t *testing.T) {
 	ni := New(123)
@@ -74,3 +79,129 @@ func TestNothing(t *testing.T) {
 		t.Errorf("Expected nb.IsValue() to be false")
 	}
 }
+
+func TestValue_Validate(t *testing.T) {
+	errNegative := errors.New("negative")
+	validate := func(val int) error {
+		if val < 0 {
+			return errNegative
+		}
+		return nil
+	}
+	tests := []struct {
+		name   string
+		value  *Value[int]
+		expect error
+	}{
+		{
+			name:   "nil",
+			value:  nil,
+			expect: nil,
+		},
+		{
+			name:   "nothing",
+			value:  &Value[int]{Wrapped: -1},
+			expect: nil,
+		},
+		{
+			name:   "valid",
+			value:  New(1).Ptr(),
+			expect: nil,
+		},
+		{
+			name:   "invalid",
+			value:  New(-1).Ptr(),
+			expect: errNegative,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.value.Validate(validate); err != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, err)
+			}
+		})
+	}
+}
+
+func TestValue_Replace(t *testing.T) {
+	v := Nothing[int]()
+	if prev := v.Replace(1); prev != Nothing[int]() {
+		t.Errorf("expected=%v, got=%v", Nothing[int](), prev)
+	}
+	if prev := v.Replace(2); prev != New(1) {
+		t.Errorf("expected=%v, got=%v", New(1), prev)
+	}
+	if v != New(2) {
+		t.Errorf("expected=%v, got=%v", New(2), v)
+	}
+}
+
+func TestValue_Take(t *testing.T) {
+	v := New(1)
+	if prev := v.Take(); prev != New(1) {
+		t.Errorf("expected=%v, got=%v", New(1), prev)
+	}
+	if v != Nothing[int]() {
+		t.Errorf("expected=%v, got=%v", Nothing[int](), v)
+	}
+	if prev := v.Take(); prev != Nothing[int]() {
+		t.Errorf("expected=%v, got=%v", Nothing[int](), prev)
+	}
+	var nilValue *Value[int]
+	if prev := nilValue.Take(); prev != Nothing[int]() {
+		t.Errorf("expected=%v, got=%v", Nothing[int](), prev)
+	}
+}
+
+func TestValue_OrZero(t *testing.T) {
+	if got := New(1).OrZero(); got != 1 {
+		t.Errorf("expected=1, got=%v", got)
+	}
+	if got := (Value[int]{Wrapped: 1}).OrZero(); got != 0 {
+		t.Errorf("expected=0, got=%v", got)
+	}
+}
+
+func TestValue_DerefAny(t *testing.T) {
+	if got := New(0).DerefAny(); got != 0 {
+		t.Errorf("expected=0, got=%v", got)
+	}
+	if got := (Value[int]{Wrapped: 1}).DerefAny(); got != nil {
+		t.Errorf("expected=nil, got=%v", got)
+	}
+}
+
+func TestValue_template(t *testing.T) {
+	type page struct {
+		Name  Value[string]
+		Count Value[int]
+	}
+	tmpl := template.Must(template.New("").Parse(`{{ with .Name.DerefAny }}Hello, {{ . }}! {{ end }}Count: {{ .Count.OrZero }}`))
+	tests := []struct {
+		name   string
+		page   page
+		expect string
+	}{
+		{
+			name:   "set",
+			page:   page{Name: New("Bob"), Count: New(2)},
+			expect: "Hello, Bob! Count: 2",
+		},
+		{
+			name:   "unset",
+			page:   page{},
+			expect: "Count: 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sb strings.Builder
+			if err := tmpl.Execute(&sb, tt.page); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := sb.String(); got != tt.expect {
+				t.Errorf("expected=%q, got=%q", tt.expect, got)
+			}
+		})
+	}
+}
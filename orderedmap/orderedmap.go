@@ -0,0 +1,258 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package orderedmap contains a map type which remembers the order in which keys were inserted.
+package orderedmap
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// OrderedMap is a map which iterates over its entries in the order in which their keys were first inserted.
+// Setting the value of an existing key does not change its position.
+//
+// An OrderedMap serializes to a JSON object with its keys in order, which makes it useful for
+// rendering configuration and for deterministic output. Like encoding/json, keys must be strings,
+// integers, or implement encoding.TextMarshaler.
+//
+// The zero value is an empty OrderedMap ready to use. An OrderedMap must not be copied after first use,
+// and is not safe for concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*entry[K, V]
+	// root is a sentinel of a circular doubly linked list; root.next is the first entry.
+	root entry[K, V]
+}
+
+type entry[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *entry[K, V]
+}
+
+// New creates an empty OrderedMap.
+func New[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{}
+}
+
+func (m *OrderedMap[K, V]) init() {
+	if m.entries == nil {
+		m.entries = make(map[K]*entry[K, V])
+		m.root.next = &m.root
+		m.root.prev = &m.root
+	}
+}
+
+// Len returns the number of entries in the map.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Get returns the value for key, and whether it is present in the map.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if e, ok := m.entries[key]; ok {
+		return e.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Set sets the value for key. A new key is added at the end of the map; an existing key keeps its position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	m.init()
+	if e, ok := m.entries[key]; ok {
+		e.value = value
+		return
+	}
+	e := &entry[K, V]{key: key, value: value, prev: m.root.prev, next: &m.root}
+	m.root.prev.next = e
+	m.root.prev = e
+	m.entries[key] = e
+}
+
+// Delete removes key from the map, and reports whether it was present.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	e, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next = nil, nil
+	delete(m.entries, key)
+	return true
+}
+
+// All returns a sequence of the keys and values in the map, in insertion order.
+// Entries may be deleted during iteration.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.entries == nil {
+			return
+		}
+		for e := m.root.next; e != &m.root; {
+			next := e.next
+			if !yield(e.key, e.value) {
+				return
+			}
+			e = next
+		}
+	}
+}
+
+// Keys returns a sequence of the keys in the map, in insertion order.
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns a sequence of the values in the map, in insertion order.
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON marshals the map as a JSON object, with its keys in insertion order.
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	first := true
+	for k, v := range m.All() {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+		ks, err := keyString(k)
+		if err != nil {
+			return nil, err
+		}
+		kb, err := json.Marshal(ks)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(kb)
+		buf.WriteByte(':')
+		vb, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(vb)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON unmarshals a JSON object into the map, replacing its contents and preserving the order of the keys.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("orderedmap: expected JSON object, got %v", tok)
+	}
+	result := New[K, V]()
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		k, err := parseKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+		var v V
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+		result.Set(k, v)
+	}
+	if _, err := dec.Token(); err != nil {
+		return err
+	}
+	*m = OrderedMap[K, V]{}
+	m.init()
+	for k, v := range result.All() {
+		m.Set(k, v)
+	}
+	return nil
+}
+
+func keyString(k any) (string, error) {
+	if tm, ok := k.(encoding.TextMarshaler); ok {
+		b, err := tm.MarshalText()
+		return string(b), err
+	}
+	rv := reflect.ValueOf(k)
+	switch rv.Kind() {
+	case reflect.String:
+		return rv.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("orderedmap: unsupported key type %T", k)
+}
+
+func parseKey[K comparable](s string) (K, error) {
+	var k K
+	if tu, ok := any(&k).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(s))
+		return k, err
+	}
+	rv := reflect.ValueOf(&k).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return k, fmt.Errorf("orderedmap: invalid key %q: %w", s, err)
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return k, fmt.Errorf("orderedmap: invalid key %q: %w", s, err)
+		}
+		rv.SetUint(n)
+	default:
+		return k, fmt.Errorf("orderedmap: unsupported key type %T", k)
+	}
+	return k, nil
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package orderedmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func ExampleOrderedMap() {
+	m := New[string, int]()
+	m.Set("zebra", 1)
+	m.Set("apple", 2)
+	m.Set("mango", 3)
+	m.Set("zebra", 4)
+	for k, v := range m.All() {
+		fmt.Println(k, v)
+	}
+	data, _ := json.Marshal(m)
+	fmt.Println(string(data))
+	// Output:
+	// zebra 4
+	// apple 2
+	// mango 3
+	// {"zebra":4,"apple":2,"mango":3}
+}
+
+func TestOrderedMap(t *testing.T) {
+	var m OrderedMap[string, int]
+	if _, ok := m.Get("a"); ok || m.Len() != 0 || m.Delete("a") {
+		t.Errorf("expected zero value to be empty")
+	}
+	for i, k := range []string{"c", "a", "b", "d"} {
+		m.Set(k, i)
+	}
+	if v, ok := m.Get("b"); !ok || v != 2 {
+		t.Errorf("expected=2, got=%v", v)
+	}
+	if !m.Delete("a") || m.Delete("a") {
+		t.Errorf("expected Delete to report presence")
+	}
+	m.Set("a", 10)
+	if keys := slices.Collect(m.Keys()); !slices.Equal(keys, []string{"c", "b", "d", "a"}) {
+		t.Errorf("expected=[c b d a], got=%v", keys)
+	}
+	if values := slices.Collect(m.Values()); !slices.Equal(values, []int{0, 2, 3, 10}) {
+		t.Errorf("expected=[0 2 3 10], got=%v", values)
+	}
+	if m.Len() != 4 {
+		t.Errorf("expected=4, got=%v", m.Len())
+	}
+}
+
+func TestOrderedMap_deleteDuringIteration(t *testing.T) {
+	m := New[int, int]()
+	for i := range 5 {
+		m.Set(i, i)
+	}
+	var seen []int
+	for k := range m.All() {
+		seen = append(seen, k)
+		m.Delete(k)
+	}
+	if !slices.Equal(seen, []int{0, 1, 2, 3, 4}) || m.Len() != 0 {
+		t.Errorf("expected all keys visited and deleted, got=%v (len=%v)", seen, m.Len())
+	}
+}
+
+func TestOrderedMap_JSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expect    string
+		expectErr bool
+	}{
+		{name: "empty", input: `{}`, expect: `{}`},
+		{name: "ordered", input: `{"b":1,"a":2,"c":3}`, expect: `{"b":1,"a":2,"c":3}`},
+		{name: "duplicate", input: `{"b":1,"a":2,"b":3}`, expect: `{"b":3,"a":2}`},
+		{name: "not-object", input: `[1]`, expectErr: true},
+		{name: "bad-value", input: `{"a":"x"}`, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New[string, int]()
+			m.Set("old", 0)
+			err := json.Unmarshal([]byte(tt.input), m)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expectErr=%v, got=%v", tt.expectErr, err)
+			}
+			if tt.expectErr {
+				return
+			}
+			data, err := json.Marshal(m)
+			if err != nil || string(data) != tt.expect {
+				t.Errorf("expected=%v, got=%s (err=%v)", tt.expect, data, err)
+			}
+		})
+	}
+}
+
+func TestOrderedMap_JSONIntKeys(t *testing.T) {
+	m := New[int, string]()
+	if err := json.Unmarshal([]byte(`{"3":"c","1":"a"}`), m); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(m)
+	if err != nil || string(data) != `{"3":"c","1":"a"}` {
+		t.Errorf(`expected={"3":"c","1":"a"}, got=%s (err=%v)`, data, err)
+	}
+	if err := json.Unmarshal([]byte(`{"x":"c"}`), m); err == nil {
+		t.Errorf("expected error for invalid int key")
+	}
+	if _, err := json.Marshal(New[float64, int]()); err != nil {
+		t.Errorf("expected empty map with unsupported key to marshal, got=%v", err)
+	}
+	f := New[float64, int]()
+	f.Set(1.5, 1)
+	if _, err := json.Marshal(f); err == nil {
+		t.Errorf("expected error for unsupported key type")
+	}
+}
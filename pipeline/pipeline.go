@@ -0,0 +1,335 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package pipeline builds staged processing pipelines connected by channels.
+//
+// A Pipeline runs a set of connected stages with a shared context, and collects the errors returned by their functions.
+// Each stage runs in its own goroutines, with its own concurrency, and stops when the context is done,
+// so a failure in any stage, or the cancellation of the context, shuts down the whole pipeline.
+//
+// Stages are connected first, and then run when the Pipeline is started with a context,
+// which ForEach and Collect do:
+//
+//	p := pipeline.New()
+//	urls := pipeline.FromSlice(p, list)
+//	pages := pipeline.Map(urls, 8, fetch)
+//	titles := pipeline.Map(pages, 1, parseTitle)
+//	result, err := pipeline.Collect(ctx, titles)
+package pipeline
+
+import (
+	"context"
+	"sync"
+
+	"github.com/justenwalker/got/chans"
+	"github.com/justenwalker/got/fault"
+)
+
+// Option configures a Pipeline.
+type Option func(*Pipeline)
+
+// CollectErrors makes the Pipeline keep running when a stage function returns an error.
+// The value which caused the error is dropped, and the error is collected to be returned by Err.
+// By default, the first error stops the Pipeline.
+func CollectErrors() Option {
+	return func(p *Pipeline) {
+		p.collect = true
+	}
+}
+
+// Pipeline is a set of connected stages, which run together once it is started.
+// A Pipeline must be created with New, and is safe for concurrent use.
+type Pipeline struct {
+	collect bool
+	wg      sync.WaitGroup
+
+	mu     sync.Mutex
+	stages []func(ctx context.Context)
+	// started is set when the Pipeline is started; parentErr and cancel are set at the same time.
+	started   bool
+	parentErr func() error
+	cancel    context.CancelFunc
+	errs      fault.List
+}
+
+// New creates a Pipeline, to which stages are added with From or FromSlice.
+func New(opts ...Option) *Pipeline {
+	p := &Pipeline{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// Start runs all the stages of the Pipeline, which stop when ctx is done or the Pipeline is stopped.
+// The stages must all be added before the Pipeline is started, and it can only be started once;
+// otherwise Start panics. ForEach and Collect start the Pipeline, so Start is only needed when consuming
+// the final stage from its Out channel.
+func (p *Pipeline) Start(ctx context.Context) {
+	p.start(ctx)
+}
+
+// start starts the Pipeline, and returns the context of its stages.
+func (p *Pipeline) start(ctx context.Context) context.Context {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.started {
+		panic("pipeline: already started")
+	}
+	p.started = true
+	p.parentErr = ctx.Err
+	ctx, p.cancel = context.WithCancel(ctx)
+	for _, stage := range p.stages {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			stage(ctx)
+		}()
+	}
+	p.stages = nil
+	return ctx
+}
+
+// Stop stops all the stages of the Pipeline. It has no effect if the Pipeline has not been started.
+func (p *Pipeline) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.cancel != nil {
+		p.cancel()
+	}
+}
+
+// Wait waits for the goroutines of all the stages to return, and returns Err.
+// Stages only return once their input is exhausted, or the Pipeline is stopped, so the final stage must be drained first.
+func (p *Pipeline) Wait() error {
+	p.wg.Wait()
+	p.Stop()
+	return p.Err()
+}
+
+// Err returns the errors returned by the stage functions so far, as a fault.List.
+// If there are none and the context the Pipeline was started with is done, it returns the context error.
+func (p *Pipeline) Err() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.errs.Err(); err != nil {
+		return err
+	}
+	if p.parentErr != nil {
+		return p.parentErr()
+	}
+	return nil
+}
+
+func (p *Pipeline) fail(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.collect {
+		p.errs.Append(err)
+		return
+	}
+	if p.errs.Len() == 0 {
+		p.errs.Append(err)
+		p.cancel()
+	}
+}
+
+// addStage adds a stage function, which runs in a goroutine tracked by Wait once the Pipeline is started.
+func (p *Pipeline) addStage(fn func(ctx context.Context)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.started {
+		panic("pipeline: stage added after the pipeline was started")
+	}
+	p.stages = append(p.stages, fn)
+}
+
+// Stage is the output of a step of a Pipeline, which is the input of the next steps.
+type Stage[T any] struct {
+	p  *Pipeline
+	ch <-chan T
+}
+
+// Pipeline returns the Pipeline the stage belongs to.
+func (s Stage[T]) Pipeline() *Pipeline {
+	return s.p
+}
+
+// Out returns the channel on which the stage sends its values, for consumers other than the functions of this package.
+// It is closed when the stage is finished or the Pipeline is stopped.
+func (s Stage[T]) Out() <-chan T {
+	return s.ch
+}
+
+// forward creates a stage which sends the values produced by fn, which must return when the context is done.
+func forward[T any](p *Pipeline, buffer int, fn func(ctx context.Context, out chan<- T)) Stage[T] {
+	out := make(chan T, buffer)
+	p.addStage(func(ctx context.Context) {
+		defer close(out)
+		fn(ctx, out)
+	})
+	return Stage[T]{p: p, ch: out}
+}
+
+// sendAll sends the values received from in to out, until in is closed or the context is done.
+func sendAll[T any](ctx context.Context, in <-chan T, out chan<- T) {
+	for v := range in {
+		if chans.SendContext(ctx, out, v) != nil {
+			return
+		}
+	}
+}
+
+// From creates a stage which forwards the values received from ch.
+func From[T any](p *Pipeline, ch <-chan T) Stage[T] {
+	return forward(p, 0, func(ctx context.Context, out chan<- T) {
+		sendAll(ctx, chans.OrDone(ctx, ch), out)
+	})
+}
+
+// FromSlice creates a stage which sends the values in the slice.
+func FromSlice[T any](p *Pipeline, values []T) Stage[T] {
+	return forward(p, 0, func(ctx context.Context, out chan<- T) {
+		for _, v := range values {
+			if chans.SendContext(ctx, out, v) != nil {
+				return
+			}
+		}
+	})
+}
+
+type result[T any] struct {
+	value T
+	keep  bool
+	err   error
+}
+
+// process runs fn on the values of s with up to n goroutines, keeping the order of the input,
+// and reports the errors to the Pipeline.
+func process[A, B any](s Stage[A], n int, fn func(ctx context.Context, a A) (B, bool, error)) Stage[B] {
+	p := s.p
+	return forward(p, 0, func(ctx context.Context, out chan<- B) {
+		results := chans.Map(ctx, s.ch, n, func(a A) result[B] {
+			b, keep, err := fn(ctx, a)
+			return result[B]{value: b, keep: keep, err: err}
+		})
+		for r := range results {
+			if r.err != nil {
+				p.fail(r.err)
+				continue
+			}
+			if !r.keep {
+				continue
+			}
+			if chans.SendContext(ctx, out, r.value) != nil {
+				return
+			}
+		}
+	})
+}
+
+// Map creates a stage which applies fn to each value of s, using up to n goroutines, and sends the results in input order.
+// If fn returns an error, the value is dropped and the error is reported to the Pipeline.
+func Map[A, B any](s Stage[A], n int, fn func(ctx context.Context, a A) (B, error)) Stage[B] {
+	return process(s, n, func(ctx context.Context, a A) (B, bool, error) {
+		b, err := fn(ctx, a)
+		return b, true, err
+	})
+}
+
+// Filter creates a stage which sends the values of s for which pred returns true, evaluating it with up to n goroutines.
+// If pred returns an error, the value is dropped and the error is reported to the Pipeline.
+func Filter[T any](s Stage[T], n int, pred func(ctx context.Context, t T) (bool, error)) Stage[T] {
+	return process(s, n, func(ctx context.Context, t T) (T, bool, error) {
+		keep, err := pred(ctx, t)
+		return t, keep, err
+	})
+}
+
+// Buffer creates a stage which buffers up to size values of s, so that a slow consumer does not block the stages before it.
+func Buffer[T any](s Stage[T], size int) Stage[T] {
+	return forward(s.p, size, func(ctx context.Context, out chan<- T) {
+		sendAll(ctx, s.ch, out)
+	})
+}
+
+// FanOut creates n stages which each receive every value of s. Each value is sent to all the stages before the next
+// value is received, so the slowest branch sets the pace; use Buffer on a branch to let it fall behind.
+func FanOut[T any](s Stage[T], n int) []Stage[T] {
+	p := s.p
+	outs := make([]chan T, n)
+	stages := make([]Stage[T], n)
+	for i := range outs {
+		outs[i] = make(chan T)
+		stages[i] = Stage[T]{p: p, ch: outs[i]}
+	}
+	p.addStage(func(ctx context.Context) {
+		defer func() {
+			for _, out := range outs {
+				close(out)
+			}
+		}()
+		for v := range s.ch {
+			for _, out := range outs {
+				if chans.SendContext(ctx, out, v) != nil {
+					return
+				}
+			}
+		}
+	})
+	return stages
+}
+
+// Merge creates a stage which sends the values of all the given stages, in no particular order.
+// The stages must belong to the same Pipeline, and there must be at least one.
+func Merge[T any](stages ...Stage[T]) Stage[T] {
+	chs := make([]<-chan T, len(stages))
+	for i, s := range stages {
+		chs[i] = s.ch
+	}
+	return forward(stages[0].p, 0, func(ctx context.Context, out chan<- T) {
+		sendAll(ctx, chans.MergeContext(ctx, chs...), out)
+	})
+}
+
+// ForEach starts the Pipeline with ctx, calls fn for each value of s, and then waits for the Pipeline to finish
+// and returns its error. If fn returns an error, the Pipeline is stopped, unless it collects errors.
+func ForEach[T any](ctx context.Context, s Stage[T], fn func(ctx context.Context, t T) error) error {
+	p := s.p
+	ctx = p.start(ctx)
+	for v := range s.ch {
+		if err := fn(ctx, v); err != nil {
+			p.fail(err)
+		}
+	}
+	return p.Wait()
+}
+
+// Collect starts the Pipeline with ctx, receives all the values of s, and then waits for the Pipeline to finish
+// and returns its error.
+func Collect[T any](ctx context.Context, s Stage[T]) ([]T, error) {
+	var values []T
+	err := ForEach(ctx, s, func(_ context.Context, t T) error {
+		values = append(values, t)
+		return nil
+	})
+	return values, err
+}
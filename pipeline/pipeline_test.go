@@ -0,0 +1,142 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+func ExampleMap() {
+	p := New()
+	numbers := FromSlice(p, []string{"1", "2", "3", "4"})
+	parsed := Map(numbers, 2, func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	even := Filter(parsed, 1, func(_ context.Context, n int) (bool, error) {
+		return n%2 == 0, nil
+	})
+	fmt.Println(Collect(context.Background(), even))
+	// Output:
+	// [2 4] <nil>
+}
+
+func TestMap_failFast(t *testing.T) {
+	p := New()
+	var inputs []string
+	for i := range 1000 {
+		inputs = append(inputs, strconv.Itoa(i))
+	}
+	inputs[10] = "bad"
+	parsed := Map(FromSlice(p, inputs), 4, func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	values, err := Collect(context.Background(), parsed)
+	var numErr *strconv.NumError
+	if !errors.As(err, &numErr) {
+		t.Errorf("expected a parse error, got=%v", err)
+	}
+	if len(values) >= 999 {
+		t.Errorf("expected the pipeline to stop early, got %v values", len(values))
+	}
+}
+
+func TestMap_collectErrors(t *testing.T) {
+	p := New(CollectErrors())
+	parsed := Map(FromSlice(p, []string{"1", "x", "3", "y"}), 2, func(_ context.Context, s string) (int, error) {
+		return strconv.Atoi(s)
+	})
+	values, err := Collect(context.Background(), parsed)
+	if !slices.Equal(values, []int{1, 3}) {
+		t.Errorf("expected=[1 3], got=%v", values)
+	}
+	var list interface{ Unwrap() []error }
+	if !errors.As(err, &list) || len(list.Unwrap()) != 2 {
+		t.Errorf("expected 2 errors, got=%v", err)
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	p := New()
+	branches := FanOut(FromSlice(p, []int{1, 2, 3}), 2)
+	doubled := Map(branches[0], 1, func(_ context.Context, n int) (int, error) { return n * 2, nil })
+	negated := Map(Buffer(branches[1], 3), 1, func(_ context.Context, n int) (int, error) { return -n, nil })
+	values, err := Collect(context.Background(), Merge(doubled, negated))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	slices.Sort(values)
+	if expect := []int{-3, -2, -1, 2, 4, 6}; !slices.Equal(values, expect) {
+		t.Errorf("expected=%v, got=%v", expect, values)
+	}
+}
+
+func TestFrom_cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := New()
+	in := make(chan int)
+	go func() {
+		in <- 1
+		cancel()
+	}()
+	err := ForEach(ctx, From(p, in), func(context.Context, int) error { return nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected=%v, got=%v", context.Canceled, err)
+	}
+}
+
+func TestForEach_error(t *testing.T) {
+	errSink := errors.New("sink failed")
+	p := New()
+	err := ForEach(context.Background(), FromSlice(p, []int{1, 2, 3}), func(context.Context, int) error {
+		return errSink
+	})
+	if !errors.Is(err, errSink) {
+		t.Errorf("expected=%v, got=%v", errSink, err)
+	}
+}
+
+func TestPipeline_Start(t *testing.T) {
+	p := New()
+	doubled := Map(FromSlice(p, []int{1, 2, 3}), 2, func(_ context.Context, n int) (int, error) { return n * 2, nil })
+	p.Start(context.Background())
+	var values []int
+	for v := range doubled.Out() {
+		values = append(values, v)
+	}
+	if err := p.Wait(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expect := []int{2, 4, 6}; !slices.Equal(values, expect) {
+		t.Errorf("expected=%v, got=%v", expect, values)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected adding a stage to a started pipeline to panic")
+		}
+	}()
+	FromSlice(p, []int{1})
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package ptr
+
+import "reflect"
+
+// DeepClone returns a pointer to a deep copy of the value pointed to by p. If p is nil, DeepClone returns nil.
+//
+// Pointers, slices, maps, arrays, interfaces and exported struct fields are copied recursively,
+// so the clone shares no mutable state with the original through them. Pointers and maps that are
+// reachable more than once are copied once, which preserves the shape of the value and allows cyclic values.
+// Map keys, channels, functions, and unexported struct fields are copied shallowly.
+func DeepClone[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	c := cloner{seen: make(map[cloneKey]reflect.Value)}
+	return c.clone(reflect.ValueOf(p)).Interface().(*T)
+}
+
+type cloneKey struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+type cloner struct {
+	seen map[cloneKey]reflect.Value
+}
+
+func (c *cloner) clone(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := cloneKey{ptr: v.Pointer(), typ: v.Type()}
+		if cp, ok := c.seen[key]; ok {
+			return cp
+		}
+		cp := reflect.New(v.Type().Elem())
+		c.seen[key] = cp
+		cp.Elem().Set(c.clone(v.Elem()))
+		return cp
+	case reflect.Map:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		key := cloneKey{ptr: v.Pointer(), typ: v.Type()}
+		if cp, ok := c.seen[key]; ok {
+			return cp
+		}
+		cp := reflect.MakeMapWithSize(v.Type(), v.Len())
+		c.seen[key] = cp
+		iter := v.MapRange()
+		for iter.Next() {
+			cp.SetMapIndex(iter.Key(), c.clone(iter.Value()))
+		}
+		return cp
+	case reflect.Slice:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		cp := reflect.MakeSlice(v.Type(), v.Len(), v.Cap())
+		for i := range v.Len() {
+			cp.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return cp
+	case reflect.Array:
+		cp := reflect.New(v.Type()).Elem()
+		for i := range v.Len() {
+			cp.Index(i).Set(c.clone(v.Index(i)))
+		}
+		return cp
+	case reflect.Struct:
+		cp := reflect.New(v.Type()).Elem()
+		cp.Set(v)
+		for i := range v.NumField() {
+			if f := cp.Field(i); f.CanSet() {
+				f.Set(c.clone(v.Field(i)))
+			}
+		}
+		return cp
+	case reflect.Interface:
+		if v.IsNil() {
+			return reflect.Zero(v.Type())
+		}
+		cp := reflect.New(v.Type()).Elem()
+		cp.Set(c.clone(v.Elem()))
+		return cp
+	default:
+		return v
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package ptr
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testCloneNode struct {
+	Name     string
+	Tags     []string
+	Attrs    map[string]*int
+	Next     *testCloneNode
+	Any      any
+	Array    [2]*int
+	private  *int
+	Children []testCloneNode
+}
+
+func TestDeepClone(t *testing.T) {
+	if actual := DeepClone[testCloneNode](nil); actual != nil {
+		t.Errorf("expected=nil, got=%v", actual)
+	}
+	shared := To(7)
+	orig := &testCloneNode{
+		Name:     "root",
+		Tags:     []string{"a", "b"},
+		Attrs:    map[string]*int{"x": To(1)},
+		Next:     &testCloneNode{Name: "next"},
+		Any:      []int{1, 2},
+		Array:    [2]*int{To(3), nil},
+		private:  shared,
+		Children: []testCloneNode{{Name: "child", Tags: []string{"c"}}},
+	}
+	cp := DeepClone(orig)
+	if !reflect.DeepEqual(orig, cp) {
+		t.Fatalf("expected clone to be equal:\n%+v\n%+v", orig, cp)
+	}
+	cp.Tags[0] = "changed"
+	*cp.Attrs["x"] = 100
+	cp.Next.Name = "changed"
+	cp.Any.([]int)[0] = 100
+	*cp.Array[0] = 100
+	cp.Children[0].Tags[0] = "changed"
+	if orig.Tags[0] != "a" || *orig.Attrs["x"] != 1 || orig.Next.Name != "next" ||
+		orig.Any.([]int)[0] != 1 || *orig.Array[0] != 3 || orig.Children[0].Tags[0] != "c" {
+		t.Errorf("expected original to be unchanged, got=%+v", orig)
+	}
+	if cp.private != shared {
+		t.Errorf("expected unexported field to be copied shallowly")
+	}
+}
+
+func TestDeepClone_cycle(t *testing.T) {
+	orig := &testCloneNode{Name: "a"}
+	orig.Next = orig
+	cp := DeepClone(orig)
+	if cp == orig || cp.Next != cp {
+		t.Errorf("expected cycle to be preserved in the clone")
+	}
+}
+
+func TestDeepClone_scalar(t *testing.T) {
+	testPtrIsEqual[int](t, 5, DeepClone(To(5)))
+	m := map[string][]int{"a": {1}}
+	cp := DeepClone(&m)
+	(*cp)["a"][0] = 2
+	if m["a"][0] != 1 {
+		t.Errorf("expected original to be unchanged, got=%v", m)
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package ptr
+
+import "github.com/justenwalker/got/optional"
+
+// ToOptional converts a pointer to T into an optional.Value[T].
+// A nil pointer becomes Nothing, otherwise the Value holds a copy of the value pointed to by p.
+func ToOptional[T any](p *T) optional.Value[T] {
+	if p == nil {
+		return optional.Nothing[T]()
+	}
+	return optional.New(*p)
+}
+
+// FromOptional converts an optional.Value[T] into a pointer to T.
+// An invalid Value becomes nil, otherwise FromOptional returns a pointer to a copy of the wrapped value.
+func FromOptional[T any](v optional.Value[T]) *T {
+	if t, ok := v.Get(); ok {
+		return &t
+	}
+	return nil
+}
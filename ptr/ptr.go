@@ -36,3 +36,58 @@ func Value[T any](pt *T) T {
 	}
 	return *pt
 }
+
+// Coalesce takes multiple pointers to T as input and returns the first non-nil pointer.
+// If all the pointers are nil or there are no pointers provided, it returns nil.
+func Coalesce[T any](ptrs ...*T) *T {
+	for _, p := range ptrs {
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// Map applies mapFn to the value pointed to by p, and returns a pointer to the result.
+// If p is nil, mapFn is not called and Map returns nil.
+func Map[A any, B any](p *A, mapFn func(a A) B) *B {
+	if p == nil {
+		return nil
+	}
+	return To(mapFn(*p))
+}
+
+// Equal reports whether a and b point to equal values.
+// Two nil pointers are equal, and a nil pointer is never equal to a non-nil pointer.
+func Equal[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// Copy returns a pointer to a shallow copy of the value pointed to by p.
+// If p is nil, Copy returns nil. Pointers, maps and slices within the value are shared with the original.
+func Copy[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// NilIfZero returns a pointer to t, or nil if t is the zero value of T.
+// This is useful for converting to structs which use pointers with omitempty.
+func NilIfZero[T comparable](t T) *T {
+	var zero T
+	if t == zero {
+		return nil
+	}
+	return &t
+}
+
+// ZeroIfNil is the inverse of NilIfZero: it returns the value pointed to by p, or the zero value of T if p is nil.
+// It is equivalent to Value.
+func ZeroIfNil[T any](p *T) T {
+	return Value(p)
+}
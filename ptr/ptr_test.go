@@ -22,7 +22,10 @@
 package ptr
 
 import (
+	"strconv"
 	"testing"
+
+	"github.com/justenwalker/got/optional"
 )
 
 func TestTo(t *testing.T) {
@@ -51,6 +54,109 @@ func TestValue(t *testing.T) {
 	testIsEqual[bool](t, true, Value[bool](To[bool](true)))
 }
 
+func TestCoalesce(t *testing.T) {
+	a, b := To(1), To(2)
+	tests := []struct {
+		name   string
+		ptrs   []*int
+		expect *int
+	}{
+		{name: "none", ptrs: nil, expect: nil},
+		{name: "all-nil", ptrs: []*int{nil, nil}, expect: nil},
+		{name: "first", ptrs: []*int{a, b}, expect: a},
+		{name: "skip-nil", ptrs: []*int{nil, b, a}, expect: b},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := Coalesce(tt.ptrs...); actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	if actual := Map(nil, strconv.Itoa); actual != nil {
+		t.Errorf("expected=nil, got=%v", *actual)
+	}
+	testPtrIsEqual[string](t, "42", Map(To(42), strconv.Itoa))
+}
+
+func TestEqual(t *testing.T) {
+	a := To(1)
+	tests := []struct {
+		name   string
+		a, b   *int
+		expect bool
+	}{
+		{name: "both-nil", a: nil, b: nil, expect: true},
+		{name: "nil-left", a: nil, b: To(1), expect: false},
+		{name: "nil-right", a: To(1), b: nil, expect: false},
+		{name: "same", a: a, b: a, expect: true},
+		{name: "equal", a: To(1), b: To(1), expect: true},
+		{name: "not-equal", a: To(1), b: To(2), expect: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := Equal(tt.a, tt.b); actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestCopy(t *testing.T) {
+	if actual := Copy[int](nil); actual != nil {
+		t.Errorf("expected=nil, got=%v", *actual)
+	}
+	orig := To(1)
+	cp := Copy(orig)
+	testPtrIsEqual[int](t, 1, cp)
+	if cp == orig {
+		t.Errorf("expected a different pointer")
+	}
+	*cp = 2
+	testPtrIsEqual[int](t, 1, orig)
+}
+
+func TestNilIfZero(t *testing.T) {
+	if actual := NilIfZero(0); actual != nil {
+		t.Errorf("expected=nil, got=%v", *actual)
+	}
+	if actual := NilIfZero(""); actual != nil {
+		t.Errorf("expected=nil, got=%v", *actual)
+	}
+	testPtrIsEqual[int](t, 1, NilIfZero(1))
+	testPtrIsEqual[string](t, "test", NilIfZero("test"))
+}
+
+func TestZeroIfNil(t *testing.T) {
+	testIsEqual[int](t, 0, ZeroIfNil[int](nil))
+	testIsEqual[string](t, "test", ZeroIfNil(To("test")))
+	testIsEqual[int](t, 0, ZeroIfNil(NilIfZero(0)))
+}
+
+func TestToOptional(t *testing.T) {
+	v := ToOptional[int](nil)
+	if v.IsValid() {
+		t.Errorf("expected Nothing, got=%v", v)
+	}
+	v = ToOptional(To(1))
+	actual, ok := v.Get()
+	if !ok {
+		t.Errorf("expected valid value")
+	}
+	testIsEqual[int](t, 1, actual)
+}
+
+func TestFromOptional(t *testing.T) {
+	if actual := FromOptional(optional.Nothing[int]()); actual != nil {
+		t.Errorf("expected=nil, got=%v", *actual)
+	}
+	testPtrIsEqual[int](t, 1, FromOptional(optional.New(1)))
+	testPtrIsEqual[int](t, 0, FromOptional(optional.New(0)))
+}
+
 func testPtrIsEqual[T comparable](t *testing.T, expected T, ptrIn *T) {
 	t.Helper()
 	if ptrIn == nil {
@@ -0,0 +1,136 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package pubsub contains an in-process, typed publish/subscribe Topic.
+package pubsub
+
+import (
+	"context"
+	"errors"
+
+	"github.com/justenwalker/got/chans"
+	"github.com/justenwalker/got/fault"
+)
+
+// ErrClosed is returned when publishing to a Topic that has been closed.
+const ErrClosed = fault.Message("pubsub: topic closed")
+
+// SubscribeOption configures a Subscription.
+type SubscribeOption func(*subscribeOptions)
+
+type subscribeOptions struct {
+	buffer int
+	policy chans.SlowSubscriberPolicy
+}
+
+// WithBuffer sets the number of values buffered for the subscriber. By default, the subscription is unbuffered.
+func WithBuffer(n int) SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.buffer = n
+	}
+}
+
+// WithPolicy sets what Publish does when the subscriber's buffer is full. By default, it is chans.PolicyBlock.
+func WithPolicy(policy chans.SlowSubscriberPolicy) SubscribeOption {
+	return func(o *subscribeOptions) {
+		o.policy = policy
+	}
+}
+
+// Topic delivers each value published to it to every subscriber.
+//
+// A Topic is built on chans.Broadcast. Each subscriber chooses its own buffer size and policy for when it is
+// not keeping up, so a slow consumer that can tolerate losing values does not hold back the others.
+//
+// A Topic must be created with NewTopic, and is safe for concurrent use.
+type Topic[T any] struct {
+	b *chans.Broadcast[T]
+}
+
+// NewTopic creates a new Topic.
+func NewTopic[T any]() *Topic[T] {
+	return &Topic[T]{b: chans.NewBroadcast[T](0, chans.PolicyBlock)}
+}
+
+// Subscription is a subscriber's registration with a Topic.
+type Subscription[T any] struct {
+	ch          <-chan T
+	unsubscribe func()
+}
+
+// C returns the channel on which the subscriber receives values. It is closed when the subscription ends.
+func (s *Subscription[T]) C() <-chan T {
+	return s.ch
+}
+
+// Unsubscribe ends the subscription and closes its channel. It is safe to call Unsubscribe more than once.
+func (s *Subscription[T]) Unsubscribe() {
+	s.unsubscribe()
+}
+
+// Subscribe registers a new subscriber, which receives published values on the Subscription's channel.
+// If the Topic is already closed, the channel is closed.
+func (t *Topic[T]) Subscribe(opts ...SubscribeOption) *Subscription[T] {
+	var o subscribeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	ch, unsubscribe := t.b.SubscribeWith(o.buffer, o.policy)
+	return &Subscription[T]{ch: ch, unsubscribe: unsubscribe}
+}
+
+// SubscribeFunc registers a new subscriber which calls fn with each published value, one at a time, in its own goroutine.
+// Values buffered when the subscription ends are still passed to fn.
+func (t *Topic[T]) SubscribeFunc(fn func(v T), opts ...SubscribeOption) *Subscription[T] {
+	s := t.Subscribe(opts...)
+	go func() {
+		for v := range s.ch {
+			fn(v)
+		}
+	}()
+	return s
+}
+
+// Publish delivers v to every current subscriber, according to each subscriber's policy.
+//
+// For subscribers with chans.PolicyBlock, Publish waits for the subscriber to accept the value; if the context is done
+// while waiting, Publish returns the context error and remaining subscribers do not receive the value.
+// If the Topic is closed, ErrClosed is returned.
+func (t *Topic[T]) Publish(ctx context.Context, v T) error {
+	if err := t.b.Send(ctx, v); err != nil {
+		if errors.Is(err, chans.ErrClosed) {
+			return ErrClosed
+		}
+		return err
+	}
+	return nil
+}
+
+// Subscribers returns the number of current subscribers.
+func (t *Topic[T]) Subscribers() int {
+	return t.b.Subscribers()
+}
+
+// Close ends all subscriptions, closing their channels. Subsequent calls to Publish return ErrClosed.
+// It is safe to call Close more than once.
+func (t *Topic[T]) Close() {
+	t.b.Close()
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/chans"
+)
+
+func ExampleTopic() {
+	topic := NewTopic[string]()
+	sub := topic.Subscribe(WithBuffer(2))
+	_ = topic.Publish(context.Background(), "hello")
+	_ = topic.Publish(context.Background(), "world")
+	topic.Close()
+	for v := range sub.C() {
+		fmt.Println(v)
+	}
+	// Output:
+	// hello
+	// world
+}
+
+func TestTopic_policies(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   []SubscribeOption
+		expect []int
+	}{
+		{name: "drop-oldest", opts: []SubscribeOption{WithBuffer(2), WithPolicy(chans.PolicyDropOldest)}, expect: []int{3, 4}},
+		{name: "drop-newest", opts: []SubscribeOption{WithBuffer(2), WithPolicy(chans.PolicyDropNewest)}, expect: []int{1, 2}},
+		{name: "unbuffered-drop", opts: []SubscribeOption{WithPolicy(chans.PolicyDropOldest)}, expect: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			topic := NewTopic[int]()
+			sub := topic.Subscribe(tt.opts...)
+			for i := 1; i <= 4; i++ {
+				if err := topic.Publish(context.Background(), i); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+			topic.Close()
+			var got []int
+			for v := range sub.C() {
+				got = append(got, v)
+			}
+			if !slices.Equal(got, tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+		})
+	}
+}
+
+func TestTopic_blockingSubscriber(t *testing.T) {
+	topic := NewTopic[int]()
+	sub := topic.Subscribe()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := topic.Publish(ctx, 1); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected=%v, got=%v", context.DeadlineExceeded, err)
+	}
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		sub.Unsubscribe()
+	}()
+	if err := topic.Publish(context.Background(), 2); err != nil {
+		t.Errorf("expected Unsubscribe to release Publish, got=%v", err)
+	}
+	sub.Unsubscribe()
+	if topic.Subscribers() != 0 {
+		t.Errorf("expected=0 subscribers, got=%v", topic.Subscribers())
+	}
+}
+
+func TestTopic_SubscribeFunc(t *testing.T) {
+	topic := NewTopic[int]()
+	var mu sync.Mutex
+	var got []int
+	done := make(chan struct{})
+	sub := topic.SubscribeFunc(func(v int) {
+		mu.Lock()
+		defer mu.Unlock()
+		got = append(got, v)
+		if len(got) == 3 {
+			close(done)
+		}
+	})
+	for i := range 3 {
+		_ = topic.Publish(context.Background(), i)
+	}
+	<-done
+	sub.Unsubscribe()
+	mu.Lock()
+	defer mu.Unlock()
+	if !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("expected=[0 1 2], got=%v", got)
+	}
+}
+
+func TestTopic_Close(t *testing.T) {
+	topic := NewTopic[int]()
+	topic.Close()
+	topic.Close()
+	if err := topic.Publish(context.Background(), 1); !errors.Is(err, ErrClosed) {
+		t.Errorf("expected=%v, got=%v", ErrClosed, err)
+	}
+	if _, ok := <-topic.Subscribe().C(); ok {
+		t.Errorf("expected subscription to a closed topic to be closed")
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package result contains a Result type, which holds either a value or an error.
+//
+// A Result is useful for carrying the outcome of an operation as a single value,
+// such as over a channel or in a slice, where a (T, error) pair cannot be used directly.
+package result
+
+// Result holds either a value of type T, or an error.
+// The zero value of a Result is Ok with the zero value of T.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok creates a successful Result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err creates a failed Result holding err. If err is nil, the Result is Ok with the zero value of T.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// Of creates a Result from the return values of a function that returns a value and an error:
+//
+//	r := result.Of(strconv.Atoi(s))
+//
+// If err is not nil, v is discarded and the Result is failed.
+func Of[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// IsOk reports whether the Result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether the Result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Unpack returns the value and error of the Result. If the Result is failed, the value is the zero value of T.
+func (r Result[T]) Unpack() (T, error) {
+	return r.value, r.err
+}
+
+// Err returns the error of the Result, or nil if it is successful.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// ValueOr returns the value of the Result, or def if it is failed.
+func (r Result[T]) ValueOr(def T) T {
+	if r.err != nil {
+		return def
+	}
+	return r.value
+}
+
+// Map returns a Result holding fn applied to the value if the Result is successful,
+// otherwise it returns the Result unchanged. To change the type of the value, use the Map function.
+func (r Result[T]) Map(fn func(v T) T) Result[T] {
+	return Map(r, fn)
+}
+
+// AndThen returns the result of fn applied to the value if the Result is successful,
+// otherwise it returns the Result unchanged. To change the type of the value, use the AndThen function.
+func (r Result[T]) AndThen(fn func(v T) Result[T]) Result[T] {
+	return AndThen(r, fn)
+}
+
+// OrElse returns the result of fn applied to the error if the Result is failed,
+// otherwise it returns the Result unchanged. It can be used to recover from an error.
+func (r Result[T]) OrElse(fn func(err error) Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return fn(r.err)
+}
+
+// Map returns a Result holding mapFn applied to the value of r if it is successful,
+// otherwise it returns a failed Result with the error of r.
+func Map[A any, B any](r Result[A], mapFn func(a A) B) Result[B] {
+	if r.err != nil {
+		return Err[B](r.err)
+	}
+	return Ok(mapFn(r.value))
+}
+
+// AndThen returns the result of fn applied to the value of r if it is successful,
+// otherwise it returns a failed Result with the error of r.
+func AndThen[A any, B any](r Result[A], fn func(a A) Result[B]) Result[B] {
+	if r.err != nil {
+		return Err[B](r.err)
+	}
+	return fn(r.value)
+}
+
+// Collect returns the values of all the results, or the first error found.
+func Collect[T any](results []Result[T]) ([]T, error) {
+	values := make([]T, len(results))
+	for i, r := range results {
+		if r.err != nil {
+			return nil, r.err
+		}
+		values[i] = r.value
+	}
+	return values, nil
+}
@@ -0,0 +1,139 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package result
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+var errTest = errors.New("test error")
+
+func ExampleOf() {
+	r := Map(Of(strconv.Atoi("21")), func(n int) string {
+		return strconv.Itoa(n * 2)
+	})
+	fmt.Println(r.Unpack())
+	r = Map(Of(strconv.Atoi("x")), strconv.Itoa)
+	fmt.Println(r.IsErr(), r.Err())
+	// Output:
+	// 42 <nil>
+	// true strconv.Atoi: parsing "x": invalid syntax
+}
+
+func TestResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		result    Result[int]
+		expectOk  bool
+		expectVal int
+	}{
+		{name: "zero", result: Result[int]{}, expectOk: true, expectVal: 0},
+		{name: "ok", result: Ok(1), expectOk: true, expectVal: 1},
+		{name: "err", result: Err[int](errTest), expectOk: false, expectVal: 0},
+		{name: "err-nil", result: Err[int](nil), expectOk: true, expectVal: 0},
+		{name: "of-ok", result: Of(1, nil), expectOk: true, expectVal: 1},
+		{name: "of-err", result: Of(1, errTest), expectOk: false, expectVal: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := tt.result.Unpack()
+			if tt.result.IsOk() != tt.expectOk || tt.result.IsErr() == tt.expectOk || (err == nil) != tt.expectOk {
+				t.Errorf("expectOk=%v, got=%v (err=%v)", tt.expectOk, tt.result.IsOk(), err)
+			}
+			if v != tt.expectVal {
+				t.Errorf("expected=%v, got=%v", tt.expectVal, v)
+			}
+			if err != tt.result.Err() {
+				t.Errorf("expected=%v, got=%v", err, tt.result.Err())
+			}
+		})
+	}
+}
+
+func TestResult_ValueOr(t *testing.T) {
+	if v := Ok(1).ValueOr(2); v != 1 {
+		t.Errorf("expected=1, got=%v", v)
+	}
+	if v := Err[int](errTest).ValueOr(2); v != 2 {
+		t.Errorf("expected=2, got=%v", v)
+	}
+}
+
+func TestResult_combinators(t *testing.T) {
+	double := func(n int) int { return n * 2 }
+	half := func(n int) Result[int] {
+		if n%2 != 0 {
+			return Err[int](fmt.Errorf("%d is odd", n))
+		}
+		return Ok(n / 2)
+	}
+	recoverZero := func(error) Result[int] { return Ok(0) }
+	tests := []struct {
+		name      string
+		result    Result[int]
+		expectVal int
+		expectErr string
+	}{
+		{name: "map-ok", result: Ok(2).Map(double), expectVal: 4},
+		{name: "map-err", result: Err[int](errTest).Map(double), expectErr: "test error"},
+		{name: "and-then-ok", result: Ok(4).AndThen(half).AndThen(half), expectVal: 1},
+		{name: "and-then-err", result: Ok(6).AndThen(half).AndThen(half), expectErr: "3 is odd"},
+		{name: "or-else-ok", result: Ok(1).OrElse(recoverZero), expectVal: 1},
+		{name: "or-else-err", result: Err[int](errTest).OrElse(recoverZero), expectVal: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := tt.result.Unpack()
+			if v != tt.expectVal {
+				t.Errorf("expected=%v, got=%v", tt.expectVal, v)
+			}
+			if fmt.Sprint(err) != fmt.Sprint(tt.expectErr) && !(err == nil && tt.expectErr == "") {
+				t.Errorf("expected=%v, got=%v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	r := AndThen(Ok("12"), func(s string) Result[int] { return Of(strconv.Atoi(s)) })
+	if v, err := r.Unpack(); err != nil || v != 12 {
+		t.Errorf("expected=12, got=%v (err=%v)", v, err)
+	}
+	r = AndThen(Err[string](errTest), func(s string) Result[int] { return Of(strconv.Atoi(s)) })
+	if !errors.Is(r.Err(), errTest) {
+		t.Errorf("expected=%v, got=%v", errTest, r.Err())
+	}
+}
+
+func TestCollect(t *testing.T) {
+	values, err := Collect([]Result[int]{Ok(1), Ok(2)})
+	if err != nil || fmt.Sprint(values) != "[1 2]" {
+		t.Errorf("expected=[1 2], got=%v (err=%v)", values, err)
+	}
+	values, err = Collect([]Result[int]{Ok(1), Err[int](errTest), Ok(3)})
+	if !errors.Is(err, errTest) || values != nil {
+		t.Errorf("expected=%v, got=%v (values=%v)", errTest, err, values)
+	}
+}
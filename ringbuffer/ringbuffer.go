@@ -0,0 +1,138 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package ringbuffer contains a fixed-capacity circular buffer, for keeping bounded histories
+// such as the most recent errors or log lines without allocating as values are added.
+package ringbuffer
+
+import "iter"
+
+// FullPolicy determines what Push does when a Ring is full.
+type FullPolicy int
+
+const (
+	// Overwrite replaces the oldest value in the Ring with the new value.
+	Overwrite FullPolicy = iota
+	// Reject discards the new value, leaving the Ring unchanged.
+	Reject
+)
+
+// Ring is a circular buffer holding up to a fixed number of values, in the order they were pushed.
+// It is not safe for concurrent use.
+type Ring[T any] struct {
+	buf    []T
+	head   int
+	size   int
+	policy FullPolicy
+}
+
+// New creates a Ring that holds up to capacity values, using policy when it is full.
+// It panics if capacity is less than 1.
+func New[T any](capacity int, policy FullPolicy) *Ring[T] {
+	if capacity < 1 {
+		panic("ringbuffer: capacity must be at least 1")
+	}
+	return &Ring[T]{buf: make([]T, capacity), policy: policy}
+}
+
+// Push adds v as the newest value in the Ring. If the Ring is full, the FullPolicy decides whether
+// the oldest value is overwritten or v is rejected. Push reports whether v was added.
+func (r *Ring[T]) Push(v T) bool {
+	if r.size == len(r.buf) {
+		if r.policy == Reject {
+			return false
+		}
+		r.buf[r.head] = v
+		r.head = (r.head + 1) % len(r.buf)
+		return true
+	}
+	r.buf[(r.head+r.size)%len(r.buf)] = v
+	r.size++
+	return true
+}
+
+// Pop removes and returns the oldest value in the Ring. It returns false if the Ring is empty.
+func (r *Ring[T]) Pop() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	v := r.buf[r.head]
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+	return v, true
+}
+
+// Peek returns the oldest value in the Ring without removing it. It returns false if the Ring is empty.
+func (r *Ring[T]) Peek() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.buf[r.head], true
+}
+
+// PeekNewest returns the newest value in the Ring without removing it. It returns false if the Ring is empty.
+func (r *Ring[T]) PeekNewest() (T, bool) {
+	if r.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return r.buf[(r.head+r.size-1)%len(r.buf)], true
+}
+
+// Len returns the number of values in the Ring.
+func (r *Ring[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the maximum number of values the Ring can hold.
+func (r *Ring[T]) Cap() int {
+	return len(r.buf)
+}
+
+// Clear removes all values from the Ring.
+func (r *Ring[T]) Clear() {
+	clear(r.buf)
+	r.head, r.size = 0, 0
+}
+
+// All returns a sequence of the values in the Ring, from oldest to newest.
+// The Ring must not be modified during iteration.
+func (r *Ring[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range r.size {
+			if !yield(r.buf[(r.head+i)%len(r.buf)]) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns a copy of the values in the Ring, from oldest to newest.
+func (r *Ring[T]) Slice() []T {
+	result := make([]T, 0, r.size)
+	for v := range r.All() {
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package ringbuffer
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func ExampleRing() {
+	recent := New[string](3, Overwrite)
+	for _, line := range []string{"a", "b", "c", "d", "e"} {
+		recent.Push(line)
+	}
+	fmt.Println(recent.Slice())
+	// Output:
+	// [c d e]
+}
+
+func TestRing_Push(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   FullPolicy
+		pushes   []int
+		expect   []int
+		rejected int
+	}{
+		{name: "partial", policy: Overwrite, pushes: []int{1, 2}, expect: []int{1, 2}},
+		{name: "full", policy: Overwrite, pushes: []int{1, 2, 3}, expect: []int{1, 2, 3}},
+		{name: "overwrite", policy: Overwrite, pushes: []int{1, 2, 3, 4, 5, 6, 7}, expect: []int{5, 6, 7}},
+		{name: "reject", policy: Reject, pushes: []int{1, 2, 3, 4, 5}, expect: []int{1, 2, 3}, rejected: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New[int](3, tt.policy)
+			rejected := 0
+			for _, v := range tt.pushes {
+				if !r.Push(v) {
+					rejected++
+				}
+			}
+			if actual := r.Slice(); !slices.Equal(actual, tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+			if rejected != tt.rejected {
+				t.Errorf("expected rejected=%v, got=%v", tt.rejected, rejected)
+			}
+			if r.Len() != len(tt.expect) || r.Cap() != 3 {
+				t.Errorf("expected len=%v cap=3, got len=%v cap=%v", len(tt.expect), r.Len(), r.Cap())
+			}
+		})
+	}
+}
+
+func TestRing_Pop(t *testing.T) {
+	r := New[int](2, Overwrite)
+	if _, ok := r.Pop(); ok {
+		t.Errorf("expected empty Pop to fail")
+	}
+	if _, ok := r.Peek(); ok {
+		t.Errorf("expected empty Peek to fail")
+	}
+	if _, ok := r.PeekNewest(); ok {
+		t.Errorf("expected empty PeekNewest to fail")
+	}
+	r.Push(1)
+	r.Push(2)
+	r.Push(3)
+	if v, ok := r.Peek(); !ok || v != 2 {
+		t.Errorf("Peek: expected=2, got=%v", v)
+	}
+	if v, ok := r.PeekNewest(); !ok || v != 3 {
+		t.Errorf("PeekNewest: expected=3, got=%v", v)
+	}
+	if v, ok := r.Pop(); !ok || v != 2 {
+		t.Errorf("Pop: expected=2, got=%v", v)
+	}
+	r.Push(4)
+	if actual := r.Slice(); !slices.Equal(actual, []int{3, 4}) {
+		t.Errorf("expected=[3 4], got=%v", actual)
+	}
+	r.Clear()
+	if r.Len() != 0 || len(r.Slice()) != 0 {
+		t.Errorf("expected Clear to empty the ring")
+	}
+}
+
+func TestNew_invalidCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+	New[int](0, Overwrite)
+}
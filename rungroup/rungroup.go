@@ -0,0 +1,85 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package rungroup runs a group of long-running actors, such as servers and workers, and stops them together.
+//
+// When any actor returns, the others are stopped, and the group returns the first actor's error.
+// This is the scaffolding of a typical service's main function: if the HTTP server fails, the background
+// workers are stopped; if a signal handler returns, everything is shut down.
+package rungroup
+
+import (
+	"context"
+	"sync"
+)
+
+// Group is a set of actors which are started and stopped together. The zero value is an empty Group.
+// Actors must be added before Run is called.
+type Group struct {
+	actors []actor
+}
+
+type actor struct {
+	run  func(ctx context.Context) error
+	stop func(err error)
+}
+
+// Add adds an actor to the group. When the group runs, run is called in its own goroutine, with a context
+// which is cancelled when the group is stopping. If stop is not nil, it is also called when the group is stopping,
+// with the error which caused it; it should make run return, for actors which do not watch the context.
+func (g *Group) Add(run func(ctx context.Context) error, stop func(err error)) {
+	g.actors = append(g.actors, actor{run: run, stop: stop})
+}
+
+// Len returns the number of actors in the group.
+func (g *Group) Len() int {
+	return len(g.actors)
+}
+
+// Run starts all the actors, and waits until one of them returns. It then stops the other actors,
+// waits for all of them to return, and returns the error of the first actor to return.
+// If ctx is cancelled, the actors are stopped through their context.
+// Run returns nil immediately if the group is empty.
+func (g *Group) Run(ctx context.Context) error {
+	if len(g.actors) == 0 {
+		return nil
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	errs := make(chan error, len(g.actors))
+	var wg sync.WaitGroup
+	for _, a := range g.actors {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- a.run(ctx)
+		}()
+	}
+	err := <-errs
+	cancel()
+	for _, a := range g.actors {
+		if a.stop != nil {
+			a.stop(err)
+		}
+	}
+	wg.Wait()
+	return err
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package rungroup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func ExampleGroup() {
+	var g Group
+	g.Add(func(ctx context.Context) error {
+		<-ctx.Done()
+		fmt.Println("worker stopped")
+		return nil
+	}, nil)
+	g.Add(func(context.Context) error {
+		return errors.New("server closed")
+	}, nil)
+	fmt.Println(g.Run(context.Background()))
+	// Output:
+	// worker stopped
+	// server closed
+}
+
+func TestGroup_Run(t *testing.T) {
+	errFirst := errors.New("first")
+	var g Group
+	stopped := make(chan error, 2)
+	g.Add(func(context.Context) error {
+		return errFirst
+	}, func(err error) {
+		stopped <- err
+	})
+	release := make(chan struct{})
+	g.Add(func(context.Context) error {
+		<-release
+		return errors.New("second")
+	}, func(err error) {
+		stopped <- err
+		close(release)
+	})
+	if err := g.Run(context.Background()); !errors.Is(err, errFirst) {
+		t.Errorf("expected=%v, got=%v", errFirst, err)
+	}
+	for range 2 {
+		if err := <-stopped; !errors.Is(err, errFirst) {
+			t.Errorf("expected stop to receive=%v, got=%v", errFirst, err)
+		}
+	}
+}
+
+func TestGroup_contextCancelled(t *testing.T) {
+	var g Group
+	for range 3 {
+		g.Add(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}, nil)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := g.Run(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected=%v, got=%v", context.DeadlineExceeded, err)
+	}
+	if g.Len() != 3 {
+		t.Errorf("expected=3, got=%v", g.Len())
+	}
+}
+
+func TestGroup_empty(t *testing.T) {
+	var g Group
+	if err := g.Run(context.Background()); err != nil {
+		t.Errorf("expected=<nil>, got=%v", err)
+	}
+}
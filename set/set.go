@@ -0,0 +1,178 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package set contains a generic Set type, holding unique comparable values.
+package set
+
+import (
+	"encoding/json"
+	"iter"
+	"maps"
+)
+
+// Set is an unordered collection of unique values.
+// It is a map, so it can be ranged over with range directly, and len returns its size.
+// A nil Set is empty, but must be created with New, Of, or make before adding values.
+//
+// A Set serializes to JSON as an array, in no particular order.
+type Set[T comparable] map[T]struct{}
+
+// New creates an empty Set.
+func New[T comparable]() Set[T] {
+	return make(Set[T])
+}
+
+// Of creates a Set containing the given values.
+func Of[T comparable](values ...T) Set[T] {
+	s := make(Set[T], len(values))
+	s.Add(values...)
+	return s
+}
+
+// FromSlice creates a Set containing the values of a slice.
+func FromSlice[T comparable](values []T) Set[T] {
+	return Of(values...)
+}
+
+// Collect creates a Set containing the values of a sequence.
+func Collect[T comparable](seq iter.Seq[T]) Set[T] {
+	s := New[T]()
+	for v := range seq {
+		s[v] = struct{}{}
+	}
+	return s
+}
+
+// Add adds the given values to the Set.
+func (s Set[T]) Add(values ...T) {
+	for _, v := range values {
+		s[v] = struct{}{}
+	}
+}
+
+// Remove removes the given values from the Set.
+func (s Set[T]) Remove(values ...T) {
+	for _, v := range values {
+		delete(s, v)
+	}
+}
+
+// Contains reports whether v is in the Set.
+func (s Set[T]) Contains(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Len returns the number of values in the Set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// All returns a sequence of the values in the Set, in no particular order.
+func (s Set[T]) All() iter.Seq[T] {
+	return maps.Keys(s)
+}
+
+// Slice returns the values in the Set as a slice, in no particular order.
+func (s Set[T]) Slice() []T {
+	result := make([]T, 0, len(s))
+	for v := range s {
+		result = append(result, v)
+	}
+	return result
+}
+
+// Clone returns a copy of the Set.
+func (s Set[T]) Clone() Set[T] {
+	result := make(Set[T], len(s))
+	for v := range s {
+		result[v] = struct{}{}
+	}
+	return result
+}
+
+// Union returns a new Set with the values that are in s or other.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	result := s.Clone()
+	for v := range other {
+		result[v] = struct{}{}
+	}
+	return result
+}
+
+// Intersect returns a new Set with the values that are in both s and other.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	result := New[T]()
+	for v := range small {
+		if large.Contains(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new Set with the values that are in s but not in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := New[T]()
+	for v := range s {
+		if !other.Contains(v) {
+			result[v] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubset reports whether every value in s is also in other.
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for v := range s {
+		if !other.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal reports whether s and other contain the same values.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// MarshalJSON marshals the Set as a JSON array.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Slice())
+}
+
+// UnmarshalJSON unmarshals a JSON array into the Set, replacing its contents. Duplicate values are ignored.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*s = Of(values...)
+	return nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package set
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func testSorted(s Set[int]) []int {
+	values := s.Slice()
+	slices.Sort(values)
+	return values
+}
+
+func ExampleSet() {
+	a := Of(1, 2, 3)
+	b := Of(2, 3, 4)
+	fmt.Println(testSorted(a.Union(b)))
+	fmt.Println(testSorted(a.Intersect(b)))
+	fmt.Println(testSorted(a.Difference(b)))
+	// Output:
+	// [1 2 3 4]
+	// [2 3]
+	// [1]
+}
+
+func TestSet(t *testing.T) {
+	s := New[string]()
+	s.Add("a", "b", "a")
+	if s.Len() != 2 || !s.Contains("a") || !s.Contains("b") || s.Contains("c") {
+		t.Errorf("expected={a,b}, got=%v", s)
+	}
+	s.Remove("a", "c")
+	if s.Len() != 1 || s.Contains("a") {
+		t.Errorf("expected={b}, got=%v", s)
+	}
+	var nilSet Set[string]
+	if nilSet.Contains("a") || nilSet.Len() != 0 {
+		t.Errorf("expected nil set to be empty")
+	}
+}
+
+func TestSet_operations(t *testing.T) {
+	tests := []struct {
+		name   string
+		actual Set[int]
+		expect []int
+	}{
+		{name: "union", actual: Of(1, 2).Union(Of(2, 3)), expect: []int{1, 2, 3}},
+		{name: "union-nil", actual: Of(1).Union(nil), expect: []int{1}},
+		{name: "intersect", actual: Of(1, 2, 3).Intersect(Of(2, 3, 4, 5)), expect: []int{2, 3}},
+		{name: "intersect-empty", actual: Of(1).Intersect(Of(2)), expect: []int{}},
+		{name: "difference", actual: Of(1, 2, 3).Difference(Of(2)), expect: []int{1, 3}},
+		{name: "from-slice", actual: FromSlice([]int{3, 1, 3}), expect: []int{1, 3}},
+		{name: "collect", actual: Collect(slices.Values([]int{2, 2, 1})), expect: []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := testSorted(tt.actual); !slices.Equal(actual, tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestSet_compare(t *testing.T) {
+	if !Of(1, 2).IsSubset(Of(1, 2, 3)) || Of(1, 4).IsSubset(Of(1, 2, 3)) {
+		t.Errorf("IsSubset: unexpected result")
+	}
+	if !Of(1, 2).Equal(Of(2, 1)) || Of(1, 2).Equal(Of(1, 2, 3)) {
+		t.Errorf("Equal: unexpected result")
+	}
+	s := Of(1, 2)
+	c := s.Clone()
+	c.Add(3)
+	if s.Contains(3) {
+		t.Errorf("expected Clone to be independent")
+	}
+	count := 0
+	for range s.All() {
+		count++
+	}
+	if count != 2 {
+		t.Errorf("expected=2, got=%v", count)
+	}
+}
+
+func TestSet_JSON(t *testing.T) {
+	data, err := json.Marshal(Of(1))
+	if err != nil || string(data) != "[1]" {
+		t.Errorf("expected=[1], got=%s (err=%v)", data, err)
+	}
+	var s Set[int]
+	if err := json.Unmarshal([]byte("[3,1,3]"), &s); err != nil {
+		t.Fatal(err)
+	}
+	if actual := testSorted(s); !slices.Equal(actual, []int{1, 3}) {
+		t.Errorf("expected=[1 3], got=%v", actual)
+	}
+	if err := json.Unmarshal([]byte(`{"a":1}`), &s); err == nil {
+		t.Errorf("expected error")
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package shutdown coordinates the graceful shutdown of a program when it receives a termination signal.
+//
+// Components register hooks as they start, and the Coordinator runs them in reverse order when the program
+// is asked to stop, so that a component is shut down before the components it depends on.
+//
+//	c := shutdown.New()
+//	db := openDB()
+//	c.Register("database", func(ctx context.Context) error { return db.Close() })
+//	srv := startServer(db)
+//	c.Register("server", srv.Shutdown)
+//	if err := c.Wait(context.Background()); err != nil {
+//	    log.Fatal(err)
+//	}
+package shutdown
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/justenwalker/got/attempt"
+	"github.com/justenwalker/got/fault"
+)
+
+// DefaultTimeout is the default time a hook is allowed to run.
+const DefaultTimeout = 30 * time.Second
+
+// Hook releases the resources of a component. It should return when ctx is done.
+type Hook func(ctx context.Context) error
+
+// Option configures a Coordinator.
+type Option func(*Coordinator)
+
+// WithTimeout sets the time each hook is allowed to run, unless it is registered with its own timeout.
+// By default, it is DefaultTimeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Coordinator) {
+		c.timeout = timeout
+	}
+}
+
+// WithSignals sets the signals which start the shutdown. By default, they are SIGINT and SIGTERM.
+func WithSignals(sigs ...os.Signal) Option {
+	return func(c *Coordinator) {
+		c.signals = sigs
+	}
+}
+
+// WithHardKill sets the function called if a second signal arrives while hooks are still running.
+// By default, the program exits immediately with status 1.
+func WithHardKill(kill func()) Option {
+	return func(c *Coordinator) {
+		c.kill = kill
+	}
+}
+
+// Coordinator runs shutdown hooks when the program receives a termination signal.
+// A Coordinator must be created with New, and is safe for concurrent use.
+type Coordinator struct {
+	timeout time.Duration
+	signals []os.Signal
+	kill    func()
+	notify  func(c chan<- os.Signal, sig ...os.Signal)
+	stop    func(c chan<- os.Signal)
+
+	mu    sync.Mutex
+	hooks []hook
+	once  sync.Once
+	done  chan struct{}
+	err   error
+}
+
+type hook struct {
+	name    string
+	timeout time.Duration
+	fn      Hook
+}
+
+// New creates a Coordinator.
+func New(opts ...Option) *Coordinator {
+	c := &Coordinator{
+		timeout: DefaultTimeout,
+		signals: []os.Signal{os.Interrupt, syscall.SIGTERM},
+		kill:    func() { os.Exit(1) },
+		notify:  signal.Notify,
+		stop:    signal.Stop,
+		done:    make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Register adds a hook, named for error messages, which runs during shutdown with the Coordinator's timeout.
+// Hooks run one at a time, in the reverse of the order they were registered.
+func (c *Coordinator) Register(name string, fn Hook) {
+	c.RegisterWithTimeout(name, c.timeout, fn)
+}
+
+// RegisterWithTimeout is like Register, but the hook is allowed to run for timeout instead of the Coordinator's timeout.
+func (c *Coordinator) RegisterWithTimeout(name string, timeout time.Duration, fn Hook) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hooks = append(c.hooks, hook{name: name, timeout: timeout, fn: fn})
+}
+
+// Shutdown runs the registered hooks in reverse order, each under its own timeout, and returns their errors
+// as a fault.List. A hook which fails or times out does not prevent the remaining hooks from running.
+//
+// The hooks only run once: later calls wait for the first one to finish, and return the same error.
+func (c *Coordinator) Shutdown(ctx context.Context) error {
+	c.once.Do(func() {
+		c.mu.Lock()
+		hooks := c.hooks
+		c.hooks = nil
+		c.mu.Unlock()
+		var errs fault.List
+		for i := len(hooks) - 1; i >= 0; i-- {
+			h := hooks[i]
+			_, err := attempt.WithTimeout(ctx, h.timeout, func(ctx context.Context) (struct{}, error) {
+				return struct{}{}, h.fn(ctx)
+			})
+			if err != nil {
+				errs.Append(fmt.Errorf("shutdown: %s: %w", h.name, err))
+			}
+		}
+		c.err = errs.Err()
+		close(c.done)
+	})
+	<-c.done
+	return c.err
+}
+
+// Done returns a channel which is closed once Shutdown has finished.
+func (c *Coordinator) Done() <-chan struct{} {
+	return c.done
+}
+
+// Wait blocks until the program receives one of the Coordinator's signals, or ctx is done, and then runs Shutdown.
+// If another signal arrives while the hooks are running, the hard kill function is called.
+func (c *Coordinator) Wait(ctx context.Context) error {
+	sigs := make(chan os.Signal, 2)
+	c.notify(sigs, c.signals...)
+	defer c.stop(sigs)
+	select {
+	case <-sigs:
+	case <-ctx.Done():
+	case <-c.done:
+		return c.err
+	}
+	go func() {
+		select {
+		case <-sigs:
+			c.kill()
+		case <-c.done:
+		}
+	}()
+	return c.Shutdown(context.WithoutCancel(ctx))
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package shutdown
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// fakeSignals replaces the signal handling of c, and returns a function which delivers a signal.
+func fakeSignals(c *Coordinator) func() {
+	registered := make(chan chan<- os.Signal, 1)
+	c.notify = func(ch chan<- os.Signal, _ ...os.Signal) {
+		registered <- ch
+	}
+	c.stop = func(chan<- os.Signal) {}
+	var ch chan<- os.Signal
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			ch = <-registered
+		})
+		ch <- syscall.SIGTERM
+	}
+}
+
+func TestCoordinator_Shutdown(t *testing.T) {
+	errHook := errors.New("hook failed")
+	c := New(WithTimeout(time.Second))
+	var mu sync.Mutex
+	var order []string
+	record := func(name string) {
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, name)
+	}
+	c.Register("first", func(context.Context) error {
+		record("first")
+		return nil
+	})
+	c.Register("second", func(context.Context) error {
+		record("second")
+		return errHook
+	})
+	c.RegisterWithTimeout("slow", time.Millisecond, func(ctx context.Context) error {
+		record("slow")
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	err := c.Shutdown(context.Background())
+	mu.Lock()
+	defer mu.Unlock()
+	if !slices.Equal(order, []string{"slow", "second", "first"}) {
+		t.Errorf("expected hooks in reverse order, got=%v", order)
+	}
+	if !errors.Is(err, errHook) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected errors of the failed hooks, got=%v", err)
+	}
+	if !strings.Contains(err.Error(), "shutdown: second: hook failed") {
+		t.Errorf("expected error to name the hook, got=%v", err)
+	}
+	if again := c.Shutdown(context.Background()); again != err || len(order) != 3 {
+		t.Errorf("expected hooks to run once, got=%v", again)
+	}
+	select {
+	case <-c.Done():
+	default:
+		t.Errorf("expected Done to be closed")
+	}
+}
+
+func TestCoordinator_Wait(t *testing.T) {
+	c := New()
+	signal := fakeSignals(c)
+	ran := make(chan struct{})
+	c.Register("hook", func(context.Context) error {
+		close(ran)
+		return nil
+	})
+	result := make(chan error, 1)
+	go func() {
+		result <- c.Wait(context.Background())
+	}()
+	signal()
+	if err := <-result; err != nil {
+		t.Errorf("expected=<nil>, got=%v", err)
+	}
+	select {
+	case <-ran:
+	default:
+		t.Errorf("expected hook to run")
+	}
+}
+
+func TestCoordinator_hardKill(t *testing.T) {
+	killed := make(chan struct{})
+	c := New(WithSignals(syscall.SIGTERM), WithHardKill(func() { close(killed) }))
+	signal := fakeSignals(c)
+	c.Register("stuck", func(context.Context) error {
+		<-killed
+		return nil
+	})
+	result := make(chan error, 1)
+	go func() {
+		result <- c.Wait(context.Background())
+	}()
+	signal()
+	signal()
+	select {
+	case <-killed:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the second signal to kill")
+	}
+	<-result
+}
+
+func TestCoordinator_Wait_contextDone(t *testing.T) {
+	c := New()
+	fakeSignals(c)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var ran bool
+	c.Register("hook", func(ctx context.Context) error {
+		ran = true
+		return ctx.Err()
+	})
+	if err := c.Wait(ctx); err != nil || !ran {
+		t.Errorf("expected hooks to run with a live context, got=%v (ran=%v)", err, ran)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package sliceutil contains slice operations which are not covered by the standard slices package.
+package sliceutil
+
+// GroupBy groups the elements of s by the key returned by keyFn. Within each group, elements keep their order in s.
+func GroupBy[S ~[]T, T any, K comparable](s S, keyFn func(t T) K) map[K]S {
+	groups := make(map[K]S)
+	for _, t := range s {
+		k := keyFn(t)
+		groups[k] = append(groups[k], t)
+	}
+	return groups
+}
+
+// Partition splits s into the elements for which pred returns true, and those for which it returns false,
+// keeping their order.
+func Partition[S ~[]T, T any](s S, pred func(t T) bool) (yes, no S) {
+	for _, t := range s {
+		if pred(t) {
+			yes = append(yes, t)
+		} else {
+			no = append(no, t)
+		}
+	}
+	return yes, no
+}
+
+// Chunk splits s into consecutive slices of up to n elements. Only the last slice may be shorter than n.
+// The chunks share the backing array of s, with their capacity limited so that appending to one does not overwrite
+// the next. Chunk panics if n is less than 1.
+func Chunk[S ~[]T, T any](s S, n int) []S {
+	if n < 1 {
+		panic("sliceutil: chunk size must be at least 1")
+	}
+	chunks := make([]S, 0, (len(s)+n-1)/n)
+	for i := 0; i < len(s); i += n {
+		end := min(i+n, len(s))
+		chunks = append(chunks, s[i:end:end])
+	}
+	return chunks
+}
+
+// Associate returns a map of the key/value pairs returned by fn for each element of s.
+// If several elements have the same key, the last one wins.
+func Associate[S ~[]T, T any, K comparable, V any](s S, fn func(t T) (K, V)) map[K]V {
+	m := make(map[K]V, len(s))
+	for _, t := range s {
+		k, v := fn(t)
+		m[k] = v
+	}
+	return m
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package sliceutil
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func ExampleGroupBy() {
+	words := []string{"apple", "avocado", "banana", "blueberry", "cherry"}
+	groups := GroupBy(words, func(w string) byte { return w[0] })
+	for _, k := range slices.Sorted(maps.Keys(groups)) {
+		fmt.Printf("%c: %v\n", k, groups[k])
+	}
+	// Output:
+	// a: [apple avocado]
+	// b: [banana blueberry]
+	// c: [cherry]
+}
+
+func TestPartition(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     []int
+		expectYes []int
+		expectNo  []int
+	}{
+		{name: "mixed", input: []int{1, 2, 3, 4, 5}, expectYes: []int{2, 4}, expectNo: []int{1, 3, 5}},
+		{name: "all", input: []int{2, 4}, expectYes: []int{2, 4}},
+		{name: "empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			yes, no := Partition(tt.input, func(n int) bool { return n%2 == 0 })
+			if !slices.Equal(yes, tt.expectYes) || !slices.Equal(no, tt.expectNo) {
+				t.Errorf("expected=(%v, %v), got=(%v, %v)", tt.expectYes, tt.expectNo, yes, no)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  []int
+		n      int
+		expect string
+	}{
+		{name: "even", input: []int{1, 2, 3, 4}, n: 2, expect: "[[1 2] [3 4]]"},
+		{name: "remainder", input: []int{1, 2, 3, 4, 5}, n: 2, expect: "[[1 2] [3 4] [5]]"},
+		{name: "larger", input: []int{1, 2}, n: 5, expect: "[[1 2]]"},
+		{name: "empty", n: 3, expect: "[]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprint(Chunk(tt.input, tt.n)); got != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+		})
+	}
+	s := []int{1, 2, 3, 4}
+	chunks := Chunk(s, 2)
+	_ = append(chunks[0], 99)
+	if s[2] != 3 {
+		t.Errorf("expected appending to a chunk not to overwrite the next one")
+	}
+}
+
+func TestChunk_invalid(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+	Chunk([]int{1}, 0)
+}
+
+func TestAssociate(t *testing.T) {
+	m := Associate([]string{"a=1", "b=2", "a=3"}, func(s string) (string, string) {
+		k, v, _ := strings.Cut(s, "=")
+		return k, v
+	})
+	if expect := map[string]string{"a": "3", "b": "2"}; !maps.Equal(m, expect) {
+		t.Errorf("expected=%v, got=%v", expect, m)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package stopwatch measures elapsed time, for ad-hoc latency measurement in application code and benchmarks.
+package stopwatch
+
+import (
+	"context"
+	"time"
+
+	"github.com/justenwalker/got/clock"
+)
+
+// Stopwatch measures the time that passes while it is running. It can be stopped and started again,
+// accumulating the running time, and can record laps.
+//
+// The zero value is a stopped Stopwatch using the real clock. A Stopwatch is not safe for concurrent use.
+type Stopwatch struct {
+	// Clock measures the time. If it is nil, clock.Real is used.
+	Clock clock.Clock
+
+	running bool
+	started time.Time
+	elapsed time.Duration
+	lapTime time.Duration
+	laps    []time.Duration
+}
+
+// StartNew creates a Stopwatch using the real clock, and starts it.
+func StartNew() *Stopwatch {
+	var s Stopwatch
+	s.Start()
+	return &s
+}
+
+func (s *Stopwatch) clock() clock.Clock {
+	if s.Clock == nil {
+		return clock.Real()
+	}
+	return s.Clock
+}
+
+// Start starts the Stopwatch, or resumes it if it was stopped. Starting a running Stopwatch has no effect.
+func (s *Stopwatch) Start() {
+	if s.running {
+		return
+	}
+	s.running = true
+	s.started = s.clock().Now()
+}
+
+// Stop stops the Stopwatch, and returns the total elapsed time. Stopping a stopped Stopwatch has no effect.
+func (s *Stopwatch) Stop() time.Duration {
+	if s.running {
+		s.elapsed += s.clock().Since(s.started)
+		s.running = false
+	}
+	return s.elapsed
+}
+
+// Running reports whether the Stopwatch is running.
+func (s *Stopwatch) Running() bool {
+	return s.running
+}
+
+// Elapsed returns the total time the Stopwatch has been running.
+func (s *Stopwatch) Elapsed() time.Duration {
+	if s.running {
+		return s.elapsed + s.clock().Since(s.started)
+	}
+	return s.elapsed
+}
+
+// Lap records a lap, and returns its duration: the running time since the previous lap, or since the Stopwatch was first started.
+func (s *Stopwatch) Lap() time.Duration {
+	total := s.Elapsed()
+	lap := total - s.lapTime
+	s.lapTime = total
+	s.laps = append(s.laps, lap)
+	return lap
+}
+
+// Laps returns the durations of the recorded laps.
+func (s *Stopwatch) Laps() []time.Duration {
+	return append([]time.Duration(nil), s.laps...)
+}
+
+// Reset stops the Stopwatch, and discards the elapsed time and laps.
+func (s *Stopwatch) Reset() {
+	clk := s.Clock
+	*s = Stopwatch{Clock: clk}
+}
+
+// Timed calls fn, and returns its results along with the time it took, as measured by the real clock.
+func Timed[T any](fn func() (T, error)) (T, time.Duration, error) {
+	clk := clock.Real()
+	start := clk.Now()
+	v, err := fn()
+	return v, clk.Since(start), err
+}
+
+// TimedContext is like Timed, but fn takes a context, and the time is measured by the clock carried by ctx; see clock.NewContext.
+func TimedContext[T any](ctx context.Context, fn func(ctx context.Context) (T, error)) (T, time.Duration, error) {
+	clk := clock.FromContext(ctx)
+	start := clk.Now()
+	v, err := fn(ctx)
+	return v, clk.Since(start), err
+}
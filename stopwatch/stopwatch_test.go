@@ -0,0 +1,97 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package stopwatch
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/clock"
+)
+
+func TestStopwatch(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	s := Stopwatch{Clock: clk}
+	clk.Advance(time.Hour) // not running
+	s.Start()
+	clk.Advance(time.Second)
+	if lap := s.Lap(); lap != time.Second {
+		t.Errorf("expected=1s, got=%v", lap)
+	}
+	clk.Advance(2 * time.Second)
+	if got := s.Stop(); got != 3*time.Second {
+		t.Errorf("expected=3s, got=%v", got)
+	}
+	clk.Advance(time.Hour) // stopped
+	if got := s.Elapsed(); got != 3*time.Second || s.Running() {
+		t.Errorf("expected=3s, got=%v", got)
+	}
+	s.Start()
+	s.Start()
+	clk.Advance(time.Second)
+	if lap := s.Lap(); lap != 3*time.Second {
+		t.Errorf("expected=3s, got=%v", lap)
+	}
+	if laps := s.Laps(); !slices.Equal(laps, []time.Duration{time.Second, 3 * time.Second}) {
+		t.Errorf("expected=[1s 3s], got=%v", laps)
+	}
+	if got := s.Elapsed(); got != 4*time.Second || !s.Running() {
+		t.Errorf("expected=4s, got=%v", got)
+	}
+	s.Reset()
+	if s.Elapsed() != 0 || s.Running() || len(s.Laps()) != 0 || s.Clock != clk {
+		t.Errorf("expected Reset to clear the stopwatch")
+	}
+}
+
+func TestStartNew(t *testing.T) {
+	s := StartNew()
+	time.Sleep(time.Millisecond)
+	if !s.Running() || s.Elapsed() < time.Millisecond {
+		t.Errorf("expected a running stopwatch, got=%v", s.Elapsed())
+	}
+}
+
+func TestTimed(t *testing.T) {
+	errFail := errors.New("fail")
+	v, d, err := Timed(func() (int, error) {
+		time.Sleep(time.Millisecond)
+		return 1, errFail
+	})
+	if v != 1 || d < time.Millisecond || !errors.Is(err, errFail) {
+		t.Errorf("expected=(1, >=1ms, %v), got=(%v, %v, %v)", errFail, v, d, err)
+	}
+}
+
+func TestTimedContext(t *testing.T) {
+	clk := clock.NewFake(time.Unix(0, 0))
+	v, d, err := TimedContext(clock.NewContext(context.Background(), clk), func(context.Context) (string, error) {
+		clk.Advance(time.Minute)
+		return "ok", nil
+	})
+	if v != "ok" || d != time.Minute || err != nil {
+		t.Errorf("expected=(ok, 1m0s, <nil>), got=(%v, %v, %v)", v, d, err)
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package stream contains Stream, a lazy sequence of values whose steps may fail.
+//
+// A Stream is built on iter.Seq2[T, error]: each step receives the values of the previous one,
+// and the first error, from the source or any step, ends the Stream. Terminal operations such as Collect
+// return that error, so I/O-backed pipelines compose without checking errors at every step.
+//
+//	lines := stream.Lines(file)
+//	records := stream.Map(lines, parseRecord)
+//	valid, err := records.Filter(Record.Valid).Collect()
+package stream
+
+import (
+	"bufio"
+	"io"
+	"iter"
+)
+
+// Stream is a lazy sequence of values which ends at the first error.
+// Streams are values, and each step returns a new Stream; iterating a Stream runs all its steps.
+type Stream[T any] struct {
+	seq iter.Seq2[T, error]
+}
+
+// From creates a Stream from a sequence of values and errors. The Stream ends at the first non-nil error.
+func From[T any](seq iter.Seq2[T, error]) Stream[T] {
+	return Stream[T]{seq: seq}
+}
+
+// FromSeq creates a Stream from a sequence of values which cannot fail.
+func FromSeq[T any](seq iter.Seq[T]) Stream[T] {
+	return Stream[T]{seq: func(yield func(T, error) bool) {
+		for t := range seq {
+			if !yield(t, nil) {
+				return
+			}
+		}
+	}}
+}
+
+// Of creates a Stream of the given values.
+func Of[T any](values ...T) Stream[T] {
+	return Stream[T]{seq: func(yield func(T, error) bool) {
+		for _, t := range values {
+			if !yield(t, nil) {
+				return
+			}
+		}
+	}}
+}
+
+// Error creates a Stream which fails immediately with err.
+func Error[T any](err error) Stream[T] {
+	return Stream[T]{seq: func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, err)
+	}}
+}
+
+// Lines creates a Stream of the lines read from r, without their line endings.
+// The Stream fails if reading from r fails.
+func Lines(r io.Reader) Stream[string] {
+	return Stream[string]{seq: func(yield func(string, error) bool) {
+		sc := bufio.NewScanner(r)
+		for sc.Scan() {
+			if !yield(sc.Text(), nil) {
+				return
+			}
+		}
+		if err := sc.Err(); err != nil {
+			yield("", err)
+		}
+	}}
+}
+
+// All returns the values of the Stream as a sequence. If the Stream fails, the last pair holds the error and the zero value.
+func (s Stream[T]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if s.seq == nil {
+			return
+		}
+		for t, err := range s.seq {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yield(t, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns a Stream of the values for which pred returns true.
+func (s Stream[T]) Filter(pred func(t T) bool) Stream[T] {
+	return FilterErr(s, func(t T) (bool, error) {
+		return pred(t), nil
+	})
+}
+
+// Take returns a Stream of the first n values. The rest of the Stream is not consumed.
+func (s Stream[T]) Take(n int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T, error) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for t, err := range s.All() {
+			if !yield(t, err) || err != nil {
+				return
+			}
+			i++
+			if i >= n {
+				return
+			}
+		}
+	}}
+}
+
+// Skip returns a Stream without the first n values.
+func (s Stream[T]) Skip(n int) Stream[T] {
+	return Stream[T]{seq: func(yield func(T, error) bool) {
+		i := 0
+		for t, err := range s.All() {
+			if err == nil && i < n {
+				i++
+				continue
+			}
+			if !yield(t, err) {
+				return
+			}
+		}
+	}}
+}
+
+// Collect returns all the values of the Stream, or the values before the error and the error if it fails.
+func (s Stream[T]) Collect() ([]T, error) {
+	var values []T
+	for t, err := range s.All() {
+		if err != nil {
+			return values, err
+		}
+		values = append(values, t)
+	}
+	return values, nil
+}
+
+// ForEach calls fn for each value of the Stream. It stops at the first error, from the Stream or from fn, and returns it.
+func (s Stream[T]) ForEach(fn func(t T) error) error {
+	for t, err := range s.All() {
+		if err != nil {
+			return err
+		}
+		if err = fn(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Count returns the number of values in the Stream, or the error if it fails.
+func (s Stream[T]) Count() (int, error) {
+	n := 0
+	err := s.ForEach(func(T) error {
+		n++
+		return nil
+	})
+	return n, err
+}
+
+// First returns the first value of the Stream, and false if it is empty. The rest of the Stream is not consumed.
+func (s Stream[T]) First() (T, bool, error) {
+	for t, err := range s.All() {
+		return t, err == nil, err
+	}
+	var zero T
+	return zero, false, nil
+}
+
+// Map returns a Stream of the results of applying fn to each value of s. The Stream fails at the first error returned by fn.
+func Map[A, B any](s Stream[A], fn func(a A) (B, error)) Stream[B] {
+	return Stream[B]{seq: func(yield func(B, error) bool) {
+		var zero B
+		for a, err := range s.All() {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			b, ferr := fn(a)
+			if ferr != nil {
+				yield(zero, ferr)
+				return
+			}
+			if !yield(b, nil) {
+				return
+			}
+		}
+	}}
+}
+
+// FilterErr returns a Stream of the values of s for which pred returns true. The Stream fails at the first error returned by pred.
+func FilterErr[T any](s Stream[T], pred func(t T) (bool, error)) Stream[T] {
+	return Stream[T]{seq: func(yield func(T, error) bool) {
+		var zero T
+		for t, err := range s.All() {
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			keep, perr := pred(t)
+			if perr != nil {
+				yield(zero, perr)
+				return
+			}
+			if keep && !yield(t, nil) {
+				return
+			}
+		}
+	}}
+}
+
+// Reduce combines the values of s into a single value, starting with init. It returns the error if the Stream fails.
+func Reduce[T, R any](s Stream[T], init R, fn func(acc R, t T) R) (R, error) {
+	acc := init
+	err := s.ForEach(func(t T) error {
+		acc = fn(acc, t)
+		return nil
+	})
+	return acc, err
+}
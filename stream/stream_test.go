@@ -0,0 +1,126 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func ExampleMap() {
+	input := strings.NewReader("1\n2\n3\nfour\n5\n")
+	numbers := Map(Lines(input), strconv.Atoi)
+	values, err := numbers.Filter(func(n int) bool { return n != 2 }).Collect()
+	fmt.Println(values)
+	fmt.Println(err)
+	// Output:
+	// [1 3]
+	// strconv.Atoi: parsing "four": invalid syntax
+}
+
+func TestStream(t *testing.T) {
+	errFail := errors.New("fail")
+	failAt := func(n int) func(int) (int, error) {
+		return func(v int) (int, error) {
+			if v == n {
+				return 0, errFail
+			}
+			return v, nil
+		}
+	}
+	tests := []struct {
+		name      string
+		stream    Stream[int]
+		expect    []int
+		expectErr error
+	}{
+		{name: "of", stream: Of(1, 2, 3), expect: []int{1, 2, 3}},
+		{name: "empty", stream: Stream[int]{}},
+		{name: "map error", stream: Map(Of(1, 2, 3), failAt(2)), expect: []int{1}, expectErr: errFail},
+		{name: "error", stream: Error[int](errFail), expectErr: errFail},
+		{name: "take", stream: Of(1, 2, 3).Take(2), expect: []int{1, 2}},
+		{name: "take before error", stream: Map(Of(1, 2, 3), failAt(3)).Take(2), expect: []int{1, 2}},
+		{name: "skip", stream: Of(1, 2, 3).Skip(2), expect: []int{3}},
+		{name: "skip error", stream: Map(Of(1, 2, 3), failAt(1)).Skip(2), expectErr: errFail},
+		{name: "filter err", stream: FilterErr(Of(1, 2, 3), func(n int) (bool, error) {
+			if n == 3 {
+				return false, errFail
+			}
+			return n == 1, nil
+		}), expect: []int{1}, expectErr: errFail},
+		{name: "from seq", stream: FromSeq(slices.Values([]int{4, 5})), expect: []int{4, 5}},
+		{name: "from", stream: From(func(yield func(int, error) bool) {
+			_ = yield(1, nil) && yield(0, errFail) && yield(2, nil)
+		}), expect: []int{1}, expectErr: errFail},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.stream.Collect()
+			if !slices.Equal(got, tt.expect) {
+				t.Errorf("expected=%v, got=%v", tt.expect, got)
+			}
+			if !errors.Is(err, tt.expectErr) {
+				t.Errorf("expected=%v, got=%v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestStream_terminal(t *testing.T) {
+	if n, err := Of("a", "b").Count(); n != 2 || err != nil {
+		t.Errorf("expected=2, got=%v (err=%v)", n, err)
+	}
+	if sum, err := Reduce(Of(1, 2, 3), 0, func(acc, n int) int { return acc + n }); sum != 6 || err != nil {
+		t.Errorf("expected=6, got=%v (err=%v)", sum, err)
+	}
+	if v, ok, err := Of(7, 8).First(); v != 7 || !ok || err != nil {
+		t.Errorf("expected=7, got=%v (ok=%v, err=%v)", v, ok, err)
+	}
+	if _, ok, err := Of[int]().First(); ok || err != nil {
+		t.Errorf("expected empty, got ok=%v (err=%v)", ok, err)
+	}
+	errStop := errors.New("stop")
+	var seen []int
+	err := Of(1, 2, 3).ForEach(func(n int) error {
+		seen = append(seen, n)
+		if n == 2 {
+			return errStop
+		}
+		return nil
+	})
+	if !errors.Is(err, errStop) || !slices.Equal(seen, []int{1, 2}) {
+		t.Errorf("expected ForEach to stop at the error, got=%v (err=%v)", seen, err)
+	}
+}
+
+func TestLines_readError(t *testing.T) {
+	errRead := errors.New("read failed")
+	lines, err := Lines(iotest.ErrReader(errRead)).Collect()
+	if len(lines) != 0 || !errors.Is(err, errRead) {
+		t.Errorf("expected=%v, got=%v (lines=%v)", errRead, err, lines)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package syncx
+
+import (
+	"sync/atomic"
+
+	"github.com/justenwalker/got/optional"
+)
+
+// Atomic is a typed wrapper around an atomically loaded and stored value of type T.
+//
+// The zero value is ready to use and holds no value; Load returns Nothing until the first Store.
+// An Atomic must not be copied after first use.
+type Atomic[T any] struct {
+	p atomic.Pointer[T]
+}
+
+// Load atomically loads the stored value.
+// If no value has been stored yet, it returns Nothing.
+func (a *Atomic[T]) Load() optional.Value[T] {
+	if p := a.p.Load(); p != nil {
+		return optional.New(*p)
+	}
+	return optional.Nothing[T]()
+}
+
+// Store atomically stores v.
+func (a *Atomic[T]) Store(v T) {
+	a.p.Store(&v)
+}
+
+// Swap atomically stores v and returns the previous value.
+// If no value had been stored, the previous value is Nothing.
+func (a *Atomic[T]) Swap(v T) optional.Value[T] {
+	if old := a.p.Swap(&v); old != nil {
+		return optional.New(*old)
+	}
+	return optional.Nothing[T]()
+}
+
+// CompareAndSwap atomically stores new if the current value is equal to old, and reports whether it did.
+// If no value has been stored yet, CompareAndSwap returns false.
+//
+// Like atomic.Value, CompareAndSwap panics if T is not a comparable type.
+func (a *Atomic[T]) CompareAndSwap(old, new T) bool {
+	for {
+		p := a.p.Load()
+		if p == nil || any(*p) != any(old) {
+			return false
+		}
+		if a.p.CompareAndSwap(p, &new) {
+			return true
+		}
+	}
+}
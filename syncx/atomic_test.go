@@ -0,0 +1,125 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package syncx
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func ExampleAtomic() {
+	var a Atomic[string]
+	initial := a.Load()
+	fmt.Println(initial.IsValid())
+	a.Store("hello")
+	fmt.Println(a.CompareAndSwap("hello", "world"))
+	old := a.Swap("goodbye")
+	fmt.Println(old.Get())
+	current := a.Load()
+	fmt.Println(current.Get())
+	// Output:
+	// false
+	// true
+	// world true
+	// goodbye true
+}
+
+func TestAtomic_Load(t *testing.T) {
+	var a Atomic[int]
+	loaded := a.Load()
+	if v, ok := loaded.Get(); ok {
+		t.Errorf("Load() = (%v,%t), want (0,false)", v, ok)
+	}
+	a.Store(0)
+	loaded = a.Load()
+	if v, ok := loaded.Get(); !ok || v != 0 {
+		t.Errorf("Load() = (%v,%t), want (0,true)", v, ok)
+	}
+}
+
+func TestAtomic_Swap(t *testing.T) {
+	var a Atomic[int]
+	old := a.Swap(1)
+	if v, ok := old.Get(); ok {
+		t.Errorf("Swap() = (%v,%t), want (0,false)", v, ok)
+	}
+	old = a.Swap(2)
+	if v, ok := old.Get(); !ok || v != 1 {
+		t.Errorf("Swap() = (%v,%t), want (1,true)", v, ok)
+	}
+}
+
+func TestAtomic_CompareAndSwap(t *testing.T) {
+	tests := []struct {
+		name   string
+		stored bool
+		value  int
+		old    int
+		new    int
+		expect bool
+		result int
+	}{
+		{name: "unset", stored: false, old: 0, new: 1, expect: false, result: 0},
+		{name: "equal", stored: true, value: 1, old: 1, new: 2, expect: true, result: 2},
+		{name: "not-equal", stored: true, value: 1, old: 3, new: 2, expect: false, result: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Atomic[int]
+			if tt.stored {
+				a.Store(tt.value)
+			}
+			if got := a.CompareAndSwap(tt.old, tt.new); got != tt.expect {
+				t.Errorf("CompareAndSwap() = %t, want %t", got, tt.expect)
+			}
+			loaded := a.Load()
+			if got := loaded.GetWithDefault(0); got != tt.result {
+				t.Errorf("Load() = %v, want %v", got, tt.result)
+			}
+		})
+	}
+}
+
+func TestAtomic_CompareAndSwap_concurrent(t *testing.T) {
+	var a Atomic[int]
+	a.Store(0)
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				loaded := a.Load()
+				v := loaded.GetWithDefault(0)
+				if a.CompareAndSwap(v, v+1) {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	loaded := a.Load()
+	if v := loaded.GetWithDefault(0); v != 100 {
+		t.Errorf("Load() = %v, want 100", v)
+	}
+}
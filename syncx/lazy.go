@@ -0,0 +1,82 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package syncx
+
+import (
+	"context"
+
+	"github.com/justenwalker/got/semaphore"
+)
+
+// Lazy is a value that is computed on first use and cached until Reset is called.
+//
+// Only successful results are cached. If the function returns an error,
+// the error is returned to the caller and the function is called again on the next Get.
+// This makes Lazy suitable for things like lazily-dialed clients, where a transient failure
+// should not poison the value forever.
+//
+// A Lazy must be created with NewLazy, and is safe for concurrent use.
+type Lazy[T any] struct {
+	fn    func(ctx context.Context) (T, error)
+	sem   semaphore.Semaphore
+	value T
+	done  bool
+}
+
+// NewLazy creates a new Lazy value which is computed by calling fn.
+func NewLazy[T any](fn func(ctx context.Context) (T, error)) *Lazy[T] {
+	return &Lazy[T]{
+		fn:  fn,
+		sem: semaphore.New(1),
+	}
+}
+
+// Get returns the cached value, computing it first if necessary.
+// Only one computation runs at a time; concurrent callers wait for it to finish.
+// If the context is cancelled while waiting, the context error is returned.
+func (l *Lazy[T]) Get(ctx context.Context) (T, error) {
+	var zero T
+	if err := l.sem.Acquire(ctx); err != nil {
+		return zero, err
+	}
+	defer l.sem.Release()
+	if l.done {
+		return l.value, nil
+	}
+	v, err := l.fn(ctx)
+	if err != nil {
+		return zero, err
+	}
+	l.value = v
+	l.done = true
+	return v, nil
+}
+
+// Reset discards the cached value, so that the next call to Get computes it again.
+// If a computation is in progress, Reset waits for it to finish.
+func (l *Lazy[T]) Reset() {
+	_ = l.sem.Acquire(context.Background())
+	defer l.sem.Release()
+	var zero T
+	l.value = zero
+	l.done = false
+}
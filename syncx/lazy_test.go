@@ -0,0 +1,138 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package syncx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func ExampleLazy() {
+	var calls int
+	lazy := NewLazy(func(ctx context.Context) (string, error) {
+		calls++
+		if calls == 1 {
+			return "", errors.New("dial failed")
+		}
+		return fmt.Sprintf("client-%d", calls), nil
+	})
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		v, err := lazy.Get(ctx)
+		fmt.Printf("value=%q error=%v\n", v, err)
+	}
+	lazy.Reset()
+	v, err := lazy.Get(ctx)
+	fmt.Printf("value=%q error=%v\n", v, err)
+	// Output:
+	// value="" error=dial failed
+	// value="client-2" error=<nil>
+	// value="client-2" error=<nil>
+	// value="client-3" error=<nil>
+}
+
+func TestLazy_Get(t *testing.T) {
+	var calls int
+	var mu sync.Mutex
+	lazy := NewLazy(func(ctx context.Context) (int, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		return 123, nil
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			v, err := lazy.Get(context.Background())
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if v != 123 {
+				t.Errorf("Get() = %v, want %v", v, 123)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Errorf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestLazy_Get_error(t *testing.T) {
+	testErr := errors.New("test")
+	var calls int
+	lazy := NewLazy(func(ctx context.Context) (int, error) {
+		calls++
+		return 0, testErr
+	})
+	for i := 0; i < 3; i++ {
+		if _, err := lazy.Get(context.Background()); !errors.Is(err, testErr) {
+			t.Fatalf("Get() error = %v, want %v", err, testErr)
+		}
+	}
+	if calls != 3 {
+		t.Errorf("expected errors not to be cached: got %d calls, want 3", calls)
+	}
+}
+
+func TestLazy_Get_contextCancelled(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	lazy := NewLazy(func(ctx context.Context) (int, error) {
+		close(started)
+		<-release
+		return 123, nil
+	})
+	go func() {
+		_, _ = lazy.Get(context.Background())
+	}()
+	<-started
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := lazy.Get(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Get() error = %v, want %v", err, context.Canceled)
+	}
+	close(release)
+	if v, err := lazy.Get(context.Background()); err != nil || v != 123 {
+		t.Errorf("Get() = (%v,%v), want (123,<nil>)", v, err)
+	}
+}
+
+func TestLazy_Reset(t *testing.T) {
+	var calls int
+	lazy := NewLazy(func(ctx context.Context) (int, error) {
+		calls++
+		return calls, nil
+	})
+	if v, _ := lazy.Get(context.Background()); v != 1 {
+		t.Errorf("Get() = %v, want 1", v)
+	}
+	lazy.Reset()
+	if v, _ := lazy.Get(context.Background()); v != 2 {
+		t.Errorf("Get() after Reset() = %v, want 2", v)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package timeutil contains helpers for recurring work driven by time.
+package timeutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/justenwalker/got/attempt"
+	"github.com/justenwalker/got/clock"
+)
+
+// MinTickerInterval is the shortest interval between the ticks of a Ticker.
+// Shorter intervals produced by its Delayer, including zero or negative ones, are raised to MinTickerInterval,
+// so that a Ticker never spins.
+const MinTickerInterval = time.Millisecond
+
+// Ticker delivers ticks on a channel like time.Ticker, but the interval before each tick is produced by an attempt.Delayer.
+// This allows a periodic poller to back off, or jitter its interval, using the same Delayers as attempt.WithRetry.
+//
+// The Delayer is called with 1 for the first tick, 2 for the second, and so on; Reset starts the count over.
+// Intervals shorter than MinTickerInterval are raised to MinTickerInterval.
+// Like time.Ticker, ticks are dropped if the receiver is slow to read them.
+type Ticker struct {
+	// C is the channel on which the ticks are delivered.
+	C <-chan time.Time
+
+	delay    attempt.Delayer
+	reset    chan struct{}
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewTicker creates a Ticker with intervals produced by delay. The Ticker stops when ctx is done or Stop is called.
+// Intervals are measured by the clock carried by ctx; see clock.NewContext.
+func NewTicker(ctx context.Context, delay attempt.Delayer) *Ticker {
+	c := make(chan time.Time, 1)
+	t := &Ticker{
+		C:     c,
+		delay: delay,
+		reset: make(chan struct{}, 1),
+		stop:  make(chan struct{}),
+		done:  make(chan struct{}),
+	}
+	go t.run(ctx, c)
+	return t
+}
+
+func (t *Ticker) run(ctx context.Context, c chan<- time.Time) {
+	defer close(t.done)
+	n := 1
+	timer := clock.FromContext(ctx).NewTimer(t.interval(n))
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.stop:
+			return
+		case <-t.reset:
+			n = 1
+		case now := <-timer.C():
+			select {
+			case c <- now:
+			default:
+			}
+			n++
+		}
+		timer.Reset(t.interval(n))
+	}
+}
+
+func (t *Ticker) interval(n int) time.Duration {
+	return max(t.delay(n), MinTickerInterval)
+}
+
+// Reset restarts the sequence of intervals, so that the next tick is delivered after delay(1) from now.
+// A poller can use this to stop backing off once it finds work to do.
+func (t *Ticker) Reset() {
+	select {
+	case t.reset <- struct{}{}:
+	default:
+	}
+}
+
+// Stop turns off the Ticker. No more ticks are delivered after Stop returns, but C is not closed,
+// to prevent a concurrent receiver from seeing an erroneous tick. It is safe to call Stop more than once.
+func (t *Ticker) Stop() {
+	t.stopOnce.Do(func() {
+		close(t.stop)
+	})
+	<-t.done
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package timeutil
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/justenwalker/got/clock"
+)
+
+func TestTicker(t *testing.T) {
+	var mu sync.Mutex
+	var attempts []int
+	tk := NewTicker(context.Background(), func(attempt int) time.Duration {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts = append(attempts, attempt)
+		return time.Millisecond
+	})
+	for range 3 {
+		select {
+		case <-tk.C:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("expected a tick")
+		}
+	}
+	tk.Reset()
+	deadline := time.After(5 * time.Second)
+	for !restarted(&mu, &attempts) {
+		select {
+		case <-tk.C:
+		case <-deadline:
+			t.Fatalf("expected Reset to restart attempts at 1, got=%v", attempts)
+		}
+	}
+	tk.Stop()
+	tk.Stop()
+	mu.Lock()
+	defer mu.Unlock()
+	if attempts[0] != 1 || attempts[1] != 2 || attempts[2] != 3 {
+		t.Errorf("expected attempts to start at 1 and increase, got=%v", attempts)
+	}
+}
+
+func restarted(mu *sync.Mutex, attempts *[]int) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	for _, a := range (*attempts)[1:] {
+		if a == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+func TestTicker_contextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	tk := NewTicker(ctx, func(int) time.Duration { return time.Hour })
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		tk.Stop()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected ticker to stop when the context is done")
+	}
+	select {
+	case <-tk.C:
+		t.Errorf("expected no tick")
+	default:
+	}
+}
+
+func TestTicker_minInterval(t *testing.T) {
+	for _, d := range []time.Duration{0, -time.Second} {
+		clk := clock.NewFake(time.Unix(0, 0))
+		tk := NewTicker(clock.NewContext(context.Background(), clk), func(int) time.Duration { return d })
+		clk.BlockUntil(1)
+		select {
+		case <-tk.C:
+			t.Fatalf("delay=%v: expected no tick before MinTickerInterval", d)
+		case <-time.After(10 * time.Millisecond):
+		}
+		clk.Advance(MinTickerInterval)
+		select {
+		case <-tk.C:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("delay=%v: expected a tick after MinTickerInterval", d)
+		}
+		tk.Stop()
+	}
+}
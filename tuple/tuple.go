@@ -0,0 +1,132 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+// Package tuple contains generic Pair and Triple types, for grouping values without declaring a struct,
+// such as channel payloads, map entries, and the results of zipping sequences together.
+package tuple
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Pair holds two values of possibly different types.
+// A Pair serializes to JSON as a two-element array.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair creates a Pair from a and b.
+func NewPair[A any, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+// Unpack returns the values of the Pair.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Swap returns a Pair with the values in the opposite order.
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+// String formats the Pair as (first, second).
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// MarshalJSON marshals the Pair as a two-element JSON array.
+func (p Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{p.First, p.Second})
+}
+
+// UnmarshalJSON unmarshals a two-element JSON array into the Pair.
+func (p *Pair[A, B]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 2 {
+		return fmt.Errorf("tuple: expected 2 elements, got %d", len(raw))
+	}
+	var v Pair[A, B]
+	if err := json.Unmarshal(raw[0], &v.First); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw[1], &v.Second); err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+// Triple holds three values of possibly different types.
+// A Triple serializes to JSON as a three-element array.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple creates a Triple from a, b and c.
+func NewTriple[A any, B any, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: a, Second: b, Third: c}
+}
+
+// Unpack returns the values of the Triple.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// String formats the Triple as (first, second, third).
+func (t Triple[A, B, C]) String() string {
+	return fmt.Sprintf("(%v, %v, %v)", t.First, t.Second, t.Third)
+}
+
+// MarshalJSON marshals the Triple as a three-element JSON array.
+func (t Triple[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third})
+}
+
+// UnmarshalJSON unmarshals a three-element JSON array into the Triple.
+func (t *Triple[A, B, C]) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("tuple: expected 3 elements, got %d", len(raw))
+	}
+	var v Triple[A, B, C]
+	if err := json.Unmarshal(raw[0], &v.First); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw[1], &v.Second); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(raw[2], &v.Third); err != nil {
+		return err
+	}
+	*t = v
+	return nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2024 Justen Walker
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy of
+// this software and associated documentation files (the "Software"), to deal in
+// the Software without restriction, including without limitation the rights to
+// use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+// the Software, and to permit persons to whom the Software is furnished to do so,
+// subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+// FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+// COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+// IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+// CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+//
+// SPDX-License-Identifier: MIT
+
+package tuple
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func ExamplePair() {
+	p := NewPair("port", 8080)
+	name, value := p.Unpack()
+	fmt.Println(name, value)
+	fmt.Println(p, p.Swap())
+	data, _ := json.Marshal(p)
+	fmt.Println(string(data))
+	// Output:
+	// port 8080
+	// (port, 8080) (8080, port)
+	// ["port",8080]
+}
+
+func TestPair_JSON(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expect    Pair[string, int]
+		expectErr bool
+	}{
+		{name: "valid", input: `["a",1]`, expect: NewPair("a", 1)},
+		{name: "too-short", input: `["a"]`, expectErr: true},
+		{name: "too-long", input: `["a",1,2]`, expectErr: true},
+		{name: "wrong-type", input: `[1,"a"]`, expectErr: true},
+		{name: "not-array", input: `{"First":"a"}`, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var actual Pair[string, int]
+			err := json.Unmarshal([]byte(tt.input), &actual)
+			if (err != nil) != tt.expectErr {
+				t.Fatalf("expectErr=%v, got=%v", tt.expectErr, err)
+			}
+			if actual != tt.expect {
+				t.Errorf("expected=%v, got=%v", tt.expect, actual)
+			}
+		})
+	}
+}
+
+func TestTriple(t *testing.T) {
+	tr := NewTriple("a", 1, true)
+	a, b, c := tr.Unpack()
+	if a != "a" || b != 1 || !c {
+		t.Errorf("expected=(a, 1, true), got=(%v, %v, %v)", a, b, c)
+	}
+	if tr.String() != "(a, 1, true)" {
+		t.Errorf("expected=(a, 1, true), got=%v", tr)
+	}
+	data, err := json.Marshal(tr)
+	if err != nil || string(data) != `["a",1,true]` {
+		t.Fatalf(`expected=["a",1,true], got=%s (err=%v)`, data, err)
+	}
+	var actual Triple[string, int, bool]
+	if err := json.Unmarshal(data, &actual); err != nil || actual != tr {
+		t.Errorf("expected=%v, got=%v (err=%v)", tr, actual, err)
+	}
+	if err := json.Unmarshal([]byte(`["a",1]`), &actual); err == nil {
+		t.Errorf("expected error for wrong number of elements")
+	}
+}